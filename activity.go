@@ -0,0 +1,37 @@
+package mailchimp
+
+import "time"
+
+const (
+	ActivityTypeOpen   = "open"
+	ActivityTypeClick  = "click"
+	ActivityTypeBounce = "bounce"
+	ActivityTypeSignup = "signup"
+	ActivityTypeUnsub  = "unsub"
+	ActivityTypeSent   = "sent"
+)
+
+var NullMemberActivityFeed = MemberActivityFeed{}
+
+type MemberActivity struct {
+	ActivityType  string    `json:"activity_type"`
+	CreatedAt     time.Time `json:"created_at_timestamp"`
+	CampaignID    string    `json:"campaign_id"`
+	CampaignTitle string    `json:"campaign_title"`
+	URL           string    `json:"url"`
+	BounceType    string    `json:"bounce_type"`
+}
+
+type MemberActivityFeed struct {
+	EmailID    string           `json:"email_id"`
+	ListID     string           `json:"list_id"`
+	Activity   []MemberActivity `json:"activity"`
+	TotalItems int              `json:"total_items"`
+}
+
+// MemberActivityFilters narrows down the activity feed of a member.
+// An empty ActivityTypes returns all types of activity.
+type MemberActivityFilters struct {
+	ActivityTypes []string
+	Page          Page
+}
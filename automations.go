@@ -0,0 +1,87 @@
+package mailchimp
+
+var (
+	NullAutomation           = Automation{}
+	NullAutomationSlice      = []Automation{}
+	NullAutomationEmailSlice = []AutomationEmail{}
+)
+
+const (
+	AutomationStatusSave    = "save"
+	AutomationStatusPaused  = "paused"
+	AutomationStatusSending = "sending"
+)
+
+// Automation is a classic MailChimp automation workflow. All fields are
+// read-only, automations are created in the MailChimp UI.
+type Automation struct {
+	ID              string               `json:"id"`
+	CreateTime      string               `json:"create_time"`
+	StartTime       string               `json:"start_time"` // empty if never started
+	Status          string               `json:"status"`
+	EmailsSent      int                  `json:"emails_sent"`
+	Recipients      AutomationRecipients `json:"recipients"`
+	Settings        AutomationSettings   `json:"settings"`
+	TriggerSettings AutomationTrigger    `json:"trigger_settings"`
+}
+
+type AutomationRecipients struct {
+	ListID   string `json:"list_id"`
+	ListName string `json:"list_name"`
+}
+
+type AutomationSettings struct {
+	Title    string `json:"title"`
+	FromName string `json:"from_name"`
+	ReplyTo  string `json:"reply_to"`
+}
+
+type AutomationTrigger struct {
+	WorkflowType  string `json:"workflow_type"`
+	WorkflowTitle string `json:"workflow_title"`
+}
+
+type automationCollection struct {
+	Automations []Automation `json:"automations"`
+	TotalItems  int          `json:"total_items"`
+}
+
+// AutomationEmail is a single email of a classic automation. WorkflowID
+// is the ID of the automation the email belongs to.
+type AutomationEmail struct {
+	ID          string                  `json:"id"`
+	WorkflowID  string                  `json:"workflow_id"`
+	Position    int                     `json:"position"`
+	Status      string                  `json:"status"`
+	EmailsSent  int                     `json:"emails_sent"`
+	SendTime    string                  `json:"send_time"`
+	ContentType string                  `json:"content_type"`
+	Settings    AutomationEmailSettings `json:"settings"`
+	Delay       AutomationDelay         `json:"delay"`
+}
+
+type AutomationEmailSettings struct {
+	SubjectLine string `json:"subject_line"`
+	PreviewText string `json:"preview_text"`
+	Title       string `json:"title"`
+	FromName    string `json:"from_name"`
+	ReplyTo     string `json:"reply_to"`
+}
+
+// AutomationDelay is the time between the trigger, or the previous
+// email, and the email being sent.
+type AutomationDelay struct {
+	Amount    int    `json:"amount"`
+	Type      string `json:"type"`
+	Direction string `json:"direction"`
+	Action    string `json:"action"`
+}
+
+type automationEmailCollection struct {
+	Emails     []AutomationEmail `json:"emails"`
+	TotalItems int               `json:"total_items"`
+}
+
+type automationSubscriberPayload struct {
+	EmailAddress string `json:"email_address"`
+}
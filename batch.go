@@ -35,3 +35,53 @@ func NewTagsOperation(listID, memberEmail string, tags []Tag) (Operation, error)
 		Body: string(body),
 	}, nil
 }
+
+func NewMemberNoteOperation(listID, memberEmail, note string) (Operation, error) {
+	body, err := json.Marshal(notePayload{Note: note})
+	if err != nil {
+		return NullOperation, err
+	}
+	return Operation{
+		Method: "POST",
+		Path: fmt.Sprintf(
+			"/lists/%s/members/%s/notes",
+			listID,
+			hashMd5(strings.ToLower(memberEmail)),
+		),
+		Body: string(body),
+	}, nil
+}
+
+func NewMemberEventOperation(listID, memberEmail string, event Event) (Operation, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return NullOperation, err
+	}
+	return Operation{
+		Method: "POST",
+		Path: fmt.Sprintf(
+			"/lists/%s/members/%s/events",
+			listID,
+			hashMd5(strings.ToLower(memberEmail)),
+		),
+		Body: string(body),
+	}, nil
+}
+
+func NewJourneyTriggerOperation(journeyID, stepID int, memberEmail string) (Operation, error) {
+	body, err := json.Marshal(journeyTriggerPayload{
+		EmailAddress: strings.ToLower(memberEmail),
+	})
+	if err != nil {
+		return NullOperation, err
+	}
+	return Operation{
+		Method: "POST",
+		Path: fmt.Sprintf(
+			"/customer-journeys/journeys/%d/steps/%d/actions/trigger",
+			journeyID,
+			stepID,
+		),
+		Body: string(body),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package mailchimp
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNewMemberNoteOperation(t *testing.T) {
+	op, err := NewMemberNoteOperation("list-id", "Test@test.com", "a note")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	expectedPath := fmt.Sprintf("/lists/list-id/members/%s/notes", hashMd5("test@test.com"))
+	if op.Path != expectedPath {
+		t.Errorf("expected path to be '%s', but was '%s'", expectedPath, op.Path)
+	}
+	if op.Method != "POST" {
+		t.Errorf("expected method to be 'POST', but was '%s'", op.Method)
+	}
+	if op.Body != "{\"note\":\"a note\"}" {
+		t.Errorf("expected body to be '{\"note\":\"a note\"}', but was '%s'", op.Body)
+	}
+}
+
+func TestNewMemberEventOperation(t *testing.T) {
+	event := Event{Name: "trial_started"}
+	op, err := NewMemberEventOperation("list-id", "test@test.com", event)
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	expectedPath := fmt.Sprintf("/lists/list-id/members/%s/events", hashMd5("test@test.com"))
+	if op.Path != expectedPath {
+		t.Errorf("expected path to be '%s', but was '%s'", expectedPath, op.Path)
+	}
+	if op.Body != "{\"name\":\"trial_started\"}" {
+		t.Errorf("expected body to be '{\"name\":\"trial_started\"}', but was '%s'", op.Body)
+	}
+}
+
+func TestNewJourneyTriggerOperation(t *testing.T) {
+	op, err := NewJourneyTriggerOperation(12, 34, "Test@Test.com")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	expectedPath := "/customer-journeys/journeys/12/steps/34/actions/trigger"
+	if op.Path != expectedPath {
+		t.Errorf("expected path to be '%s', but was '%s'", expectedPath, op.Path)
+	}
+	if op.Method != "POST" {
+		t.Errorf("expected method to be 'POST', but was '%s'", op.Method)
+	}
+	if op.Body != "{\"email_address\":\"test@test.com\"}" {
+		t.Errorf("expected body to be '{\"email_address\":\"test@test.com\"}', but was '%s'", op.Body)
+	}
+}
@@ -0,0 +1,498 @@
+package mailchimp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	NullCampaign            = Campaign{}
+	NullCampaignSlice       = []Campaign{}
+	NullCampaignFolder      = CampaignFolder{}
+	NullCampaignFolderSlice = []CampaignFolder{}
+)
+
+const (
+	CampaignTypeRegular   = "regular"
+	CampaignTypePlaintext = "plaintext"
+	CampaignTypeABSplit   = "absplit"
+	CampaignTypeRSS       = "rss"
+	CampaignTypeVariate   = "variate"
+)
+
+const (
+	CampaignStatusSave      = "save"
+	CampaignStatusPaused    = "paused"
+	CampaignStatusSchedule  = "schedule"
+	CampaignStatusSending   = "sending"
+	CampaignStatusSent      = "sent"
+	CampaignStatusCanceled  = "canceled"
+	CampaignStatusCanceling = "canceling"
+	CampaignStatusArchived  = "archived"
+)
+
+// Campaign is a MailChimp email campaign. Only Type, Recipients and
+// Settings are sent to MailChimp, the remaining fields are read-only.
+type Campaign struct {
+	ID             string             `json:"id"`
+	WebID          int                `json:"web_id"`
+	Type           string             `json:"type" mc_validator:"required"`
+	CreateTime     string             `json:"create_time"`
+	Status         string             `json:"status"`
+	EmailsSent     int                `json:"emails_sent"`
+	SendTime       string             `json:"send_time"`
+	ContentType    string             `json:"content_type"`
+	ArchiveURL     string             `json:"archive_url"`
+	LongArchiveURL string             `json:"long_archive_url"`
+	Recipients     CampaignRecipients `json:"recipients"`
+	Settings       CampaignSettings   `json:"settings"`
+}
+
+// CampaignRecipients selects the list, and optionally a segment of the
+// list, that the campaign is sent to. ListName, SegmentText and
+// RecipientCount are read-only.
+type CampaignRecipients struct {
+	ListID         string               `json:"list_id" mc_validator:"required"`
+	ListName       string               `json:"list_name,omitempty"`
+	SegmentText    string               `json:"segment_text,omitempty"`
+	RecipientCount int                  `json:"recipient_count,omitempty"`
+	SegmentOpts    *CampaignSegmentOpts `json:"segment_opts,omitempty"`
+}
+
+// CampaignSegmentOpts narrows the recipients down to a saved segment,
+// or to the members matching the given conditions.
+type CampaignSegmentOpts struct {
+	SavedSegmentID int                `json:"saved_segment_id,omitempty"`
+	Match          string             `json:"match,omitempty"`
+	Conditions     []SegmentCondition `json:"conditions,omitempty"`
+}
+
+type SegmentCondition struct {
+	ConditionType string      `json:"condition_type"`
+	Field         string      `json:"field"`
+	Op            string      `json:"op"`
+	Value         interface{} `json:"value"`
+}
+
+// CampaignSettings holds the settings of a campaign. UseConversation,
+// Authenticate, AutoFooter and InlineCSS are nil unless set with the
+// builder, in which case MailChimp's defaults are used.
+type CampaignSettings struct {
+	SubjectLine     string `json:"subject_line" mc_validator:"required"`
+	PreviewText     string `json:"preview_text,omitempty"`
+	Title           string `json:"title,omitempty"`
+	FromName        string `json:"from_name" mc_validator:"required"`
+	ReplyTo         string `json:"reply_to" mc_validator:"required"`
+	ToName          string `json:"to_name,omitempty"`
+	UseConversation *bool  `json:"use_conversation,omitempty"`
+	Authenticate    *bool  `json:"authenticate,omitempty"`
+	AutoFooter      *bool  `json:"auto_footer,omitempty"`
+	InlineCSS       *bool  `json:"inline_css,omitempty"`
+	TemplateID      int    `json:"template_id,omitempty"`
+	FolderID        string `json:"folder_id,omitempty"`
+}
+
+// CampaignFilters narrows down the campaigns returned by FetchCampaigns.
+// Empty fields are not filtered on.
+type CampaignFilters struct {
+	Type     string
+	Status   string
+	ListID   string
+	FolderID string
+	Page     Page
+}
+
+type campaignCollection struct {
+	Campaigns  []Campaign `json:"campaigns"`
+	TotalItems int        `json:"total_items"`
+}
+
+type CampaignFolder struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type campaignFolderCollection struct {
+	Folders    []CampaignFolder `json:"folders"`
+	TotalItems int              `json:"total_items"`
+}
+
+type campaignFolderPayload struct {
+	Name string `json:"name"`
+}
+
+type createCampaignPayload struct {
+	Type       string             `json:"type"`
+	Recipients CampaignRecipients `json:"recipients"`
+	Settings   CampaignSettings   `json:"settings"`
+}
+
+// updateCampaignPayload is sent by UpdateCampaign. The recipients are
+// left out when no list is set, while all settings are sent except for
+// the flags that are nil. FolderID
+// is always sent, so that an empty folder ID moves the campaign out of
+// its folder.
+type updateCampaignPayload struct {
+	Recipients *CampaignRecipients    `json:"recipients,omitempty"`
+	Settings   campaignSettingsUpdate `json:"settings"`
+}
+
+type campaignSettingsUpdate struct {
+	SubjectLine     string `json:"subject_line"`
+	PreviewText     string `json:"preview_text,omitempty"`
+	Title           string `json:"title,omitempty"`
+	FromName        string `json:"from_name"`
+	ReplyTo         string `json:"reply_to"`
+	ToName          string `json:"to_name,omitempty"`
+	UseConversation *bool  `json:"use_conversation,omitempty"`
+	Authenticate    *bool  `json:"authenticate,omitempty"`
+	AutoFooter      *bool  `json:"auto_footer,omitempty"`
+	InlineCSS       *bool  `json:"inline_css,omitempty"`
+	TemplateID      int    `json:"template_id,omitempty"`
+	FolderID        string `json:"folder_id"`
+}
+
+func newUpdateCampaignPayload(c Campaign) updateCampaignPayload {
+	payload := updateCampaignPayload{
+		Settings: campaignSettingsUpdate(c.Settings),
+	}
+	if c.Recipients.ListID != "" {
+		recipients := c.Recipients
+		payload.Recipients = &recipients
+	}
+	return payload
+}
+
+type CampaignBuilder struct {
+	obj Campaign
+}
+
+func (cb CampaignBuilder) Build() (Campaign, error) {
+	if invalidParams, valid := validate(cb.obj); !valid {
+		return NullCampaign, fmt.Errorf(
+			"could not build campaign due to invalid parameters %v",
+			invalidParams,
+		)
+	}
+	if !validCampaignType(cb.obj.Type) {
+		return NullCampaign, fmt.Errorf(
+			"could not build campaign due to invalid type '%s'",
+			cb.obj.Type,
+		)
+	}
+	if invalidParams, valid := validate(cb.obj.Recipients); !valid {
+		return NullCampaign, fmt.Errorf(
+			"could not build campaign due to invalid recipients parameters %v",
+			invalidParams,
+		)
+	}
+	if invalidParams, valid := validate(cb.obj.Settings); !valid {
+		return NullCampaign, fmt.Errorf(
+			"could not build campaign due to invalid settings parameters %v",
+			invalidParams,
+		)
+	}
+	return cb.obj, nil
+}
+
+func (cb CampaignBuilder) TypeRegular() CampaignBuilder {
+	cb.obj.Type = CampaignTypeRegular
+	return cb
+}
+
+func (cb CampaignBuilder) TypePlaintext() CampaignBuilder {
+	cb.obj.Type = CampaignTypePlaintext
+	return cb
+}
+
+func (cb CampaignBuilder) TypeABSplit() CampaignBuilder {
+	cb.obj.Type = CampaignTypeABSplit
+	return cb
+}
+
+func (cb CampaignBuilder) TypeRSS() CampaignBuilder {
+	cb.obj.Type = CampaignTypeRSS
+	return cb
+}
+
+func (cb CampaignBuilder) TypeVariate() CampaignBuilder {
+	cb.obj.Type = CampaignTypeVariate
+	return cb
+}
+
+func (cb CampaignBuilder) ListID(listID string) CampaignBuilder {
+	cb.obj.Recipients.ListID = listID
+	return cb
+}
+
+// SavedSegmentID limits the recipients to a saved segment of the list.
+func (cb CampaignBuilder) SavedSegmentID(segmentID int) CampaignBuilder {
+	cb.obj.Recipients.SegmentOpts = &CampaignSegmentOpts{
+		SavedSegmentID: segmentID,
+	}
+	return cb
+}
+
+// SegmentOpts limits the recipients to the members of the list that
+// match the given segment options.
+func (cb CampaignBuilder) SegmentOpts(opts CampaignSegmentOpts) CampaignBuilder {
+	cb.obj.Recipients.SegmentOpts = &opts
+	return cb
+}
+
+func (cb CampaignBuilder) SubjectLine(subjectLine string) CampaignBuilder {
+	cb.obj.Settings.SubjectLine = subjectLine
+	return cb
+}
+
+func (cb CampaignBuilder) PreviewText(previewText string) CampaignBuilder {
+	cb.obj.Settings.PreviewText = previewText
+	return cb
+}
+
+func (cb CampaignBuilder) Title(title string) CampaignBuilder {
+	cb.obj.Settings.Title = title
+	return cb
+}
+
+func (cb CampaignBuilder) FromName(fromName string) CampaignBuilder {
+	cb.obj.Settings.FromName = fromName
+	return cb
+}
+
+func (cb CampaignBuilder) ReplyTo(replyTo string) CampaignBuilder {
+	cb.obj.Settings.ReplyTo = replyTo
+	return cb
+}
+
+func (cb CampaignBuilder) ToName(toName string) CampaignBuilder {
+	cb.obj.Settings.ToName = toName
+	return cb
+}
+
+func (cb CampaignBuilder) UseConversation(useConversation bool) CampaignBuilder {
+	cb.obj.Settings.UseConversation = &useConversation
+	return cb
+}
+
+func (cb CampaignBuilder) Authenticate(authenticate bool) CampaignBuilder {
+	cb.obj.Settings.Authenticate = &authenticate
+	return cb
+}
+
+func (cb CampaignBuilder) AutoFooter(autoFooter bool) CampaignBuilder {
+	cb.obj.Settings.AutoFooter = &autoFooter
+	return cb
+}
+
+func (cb CampaignBuilder) InlineCSS(inlineCSS bool) CampaignBuilder {
+	cb.obj.Settings.InlineCSS = &inlineCSS
+	return cb
+}
+
+// FolderID moves the campaign to the campaign folder of the given ID.
+func (cb CampaignBuilder) FolderID(folderID string) CampaignBuilder {
+	cb.obj.Settings.FolderID = folderID
+	return cb
+}
+
+func validCampaignType(campaignType string) bool {
+	switch campaignType {
+	case CampaignTypeRegular,
+		CampaignTypePlaintext,
+		CampaignTypeABSplit,
+		CampaignTypeRSS,
+		CampaignTypeVariate:
+		return true
+	}
+	return false
+}
+
+// CampaignContent is the content of a campaign, which is either raw
+// HTML, a template with section overrides or a URL to import the HTML
+// from. Use NewHTMLCampaignContent, NewTemplateCampaignContent or
+// NewURLCampaignContent to create it. Plaintext campaigns only need
+// PlainText to be set.
+type CampaignContent struct {
+	PlainText string                   `json:"plain_text,omitempty"`
+	HTML      string                   `json:"html,omitempty"`
+	URL       string                   `json:"url,omitempty"`
+	Template  *CampaignContentTemplate `json:"template,omitempty"`
+}
+
+// CampaignContentTemplate selects a template by ID, where Sections
+// replaces the content of the editable sections of the template by
+// their names.
+type CampaignContentTemplate struct {
+	ID       int               `json:"id"`
+	Sections map[string]string `json:"sections,omitempty"`
+}
+
+// RenderedCampaignContent is the content of a campaign as rendered by
+// MailChimp.
+type RenderedCampaignContent struct {
+	PlainText   string `json:"plain_text"`
+	HTML        string `json:"html"`
+	ArchiveHTML string `json:"archive_html"`
+}
+
+var NullRenderedCampaignContent = RenderedCampaignContent{}
+
+// NewHTMLCampaignContent returns content made up of raw HTML. If the
+// plain text is empty, MailChimp generates it from the HTML.
+func NewHTMLCampaignContent(html, plainText string) CampaignContent {
+	return CampaignContent{
+		HTML:      html,
+		PlainText: plainText,
+	}
+}
+
+// NewTemplateCampaignContent returns content based on the template of
+// the given ID, with the given sections replaced.
+func NewTemplateCampaignContent(templateID int, sections map[string]string) CampaignContent {
+	return CampaignContent{
+		Template: &CampaignContentTemplate{
+			ID:       templateID,
+			Sections: sections,
+		},
+	}
+}
+
+// NewURLCampaignContent returns content imported from the given URL.
+func NewURLCampaignContent(url string) CampaignContent {
+	return CampaignContent{URL: url}
+}
+
+func (cc CampaignContent) validate() error {
+	sources := 0
+	if cc.HTML != "" {
+		sources++
+	}
+	if cc.URL != "" {
+		sources++
+	}
+	if cc.Template != nil {
+		sources++
+	}
+	if sources > 1 {
+		return errors.New(
+			"campaign content can only be set from one of html, url or template",
+		)
+	}
+	if sources == 0 && cc.PlainText == "" {
+		return errors.New("campaign content is empty")
+	}
+	return nil
+}
+
+const (
+	TestEmailTypeHTML      = "html"
+	TestEmailTypePlaintext = "plaintext"
+)
+
+// BatchDelivery spreads the sending of a scheduled campaign out over
+// BatchCount batches with BatchDelay minutes in between.
+type BatchDelivery struct {
+	BatchDelay int `json:"batch_delay"`
+	BatchCount int `json:"batch_count"`
+}
+
+// CampaignNotReadyError is returned when MailChimp refuses to send a
+// campaign because it does not pass the send checklist, for example
+// because it has no content or no recipients.
+//
+// Errors holds the problems reported by MailChimp when sending, while
+// Items holds the failing send checklist items when the campaign was
+// sent with SendCampaignWithChecklist.
+type CampaignNotReadyError struct {
+	CampaignID string
+	Detail     string
+	Errors     []APIFieldError
+	Items      []SendChecklistItem
+}
+
+func (e *CampaignNotReadyError) Error() string {
+	problems := make([]string, 0, len(e.Errors)+len(e.Items))
+	for _, fieldError := range e.Errors {
+		problems = append(problems, fieldError.Message)
+	}
+	for _, item := range e.Items {
+		problems = append(problems, item.Heading)
+	}
+	if len(problems) == 0 {
+		return fmt.Sprintf("campaign %s is not ready to send: %s", e.CampaignID, e.Detail)
+	}
+	return fmt.Sprintf(
+		"campaign %s is not ready to send: %s (%s)",
+		e.CampaignID,
+		e.Detail,
+		strings.Join(problems, ", "),
+	)
+}
+
+const (
+	ChecklistItemTypeSuccess = "success"
+	ChecklistItemTypeWarning = "warning"
+	ChecklistItemTypeError   = "error"
+)
+
+var NullSendChecklist = SendChecklist{}
+
+// SendChecklist holds the same checks MailChimp shows in its UI before
+// a campaign is sent.
+type SendChecklist struct {
+	Ready bool                `json:"is_ready"`
+	Items []SendChecklistItem `json:"items"`
+}
+
+type SendChecklistItem struct {
+	Type    string `json:"type"`
+	ID      int    `json:"id"`
+	Heading string `json:"heading"`
+	Details string `json:"details"`
+}
+
+// IsReady returns true if the campaign can be sent, meaning that none
+// of the checklist items are errors. Warnings do not stop a campaign
+// from being sent.
+func (sc SendChecklist) IsReady() bool {
+	return sc.Ready && len(sc.FailingItems()) == 0
+}
+
+// FailingItems returns the checklist items that stop the campaign from
+// being sent.
+func (sc SendChecklist) FailingItems() []SendChecklistItem {
+	failing := make([]SendChecklistItem, 0)
+	for _, item := range sc.Items {
+		if item.Type == ChecklistItemTypeError {
+			failing = append(failing, item)
+		}
+	}
+	return failing
+}
+
+// campaignNotReady converts bad request errors from the send, test and
+// schedule actions into a CampaignNotReadyError.
+func campaignNotReady(campaignID string, err error) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.Status == 400 {
+		return &CampaignNotReadyError{
+			CampaignID: campaignID,
+			Detail:     apiErr.Detail,
+			Errors:     apiErr.Errors,
+		}
+	}
+	return err
+}
+
+func validateScheduleTime(scheduleTime time.Time) error {
+	if scheduleTime.Minute()%15 != 0 || scheduleTime.Second() != 0 || scheduleTime.Nanosecond() != 0 {
+		return errors.New(
+			"campaigns can only be scheduled on the quarter-hour (:00, :15, :30, :45)",
+		)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package mailchimp
+
+import "testing"
+
+func validCampaignBuilder() CampaignBuilder {
+	return CampaignBuilder{}.
+		TypeRegular().
+		ListID("list-id").
+		SubjectLine("Our newsletter").
+		FromName("Test").
+		ReplyTo("reply@test.com")
+}
+
+func TestCampaignBuilder_BuildShouldPass(t *testing.T) {
+	campaign, err := validCampaignBuilder().SavedSegmentID(42).Build()
+	if err != nil {
+		t.Errorf(
+			"expected no error to be returned, but got '%s'",
+			err.Error(),
+		)
+	}
+	if campaign.Recipients.SegmentOpts == nil || campaign.Recipients.SegmentOpts.SavedSegmentID != 42 {
+		t.Errorf("expected saved segment ID to be 42, but got %+v", campaign.Recipients.SegmentOpts)
+	}
+}
+
+func TestCampaignBuilder_BuildWithoutTypeReturnsError(t *testing.T) {
+	builder := validCampaignBuilder()
+	builder.obj.Type = ""
+	if _, err := builder.Build(); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestCampaignBuilder_BuildWithInvalidTypeReturnsError(t *testing.T) {
+	builder := validCampaignBuilder()
+	builder.obj.Type = "carrier-pigeon"
+	if _, err := builder.Build(); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestCampaignBuilder_BuildWithoutListIDReturnsError(t *testing.T) {
+	if _, err := validCampaignBuilder().ListID("").Build(); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestCampaignBuilder_BuildWithoutSettingsReturnsError(t *testing.T) {
+	builders := []CampaignBuilder{
+		validCampaignBuilder().SubjectLine(""),
+		validCampaignBuilder().FromName(""),
+		validCampaignBuilder().ReplyTo(""),
+	}
+	for _, builder := range builders {
+		if _, err := builder.Build(); err == nil {
+			t.Error("expected error to be returned, but none was")
+		}
+	}
+}
+
+func TestCampaignBuilder_Types(t *testing.T) {
+	builders := map[string]CampaignBuilder{
+		CampaignTypeRegular:   CampaignBuilder{}.TypeRegular(),
+		CampaignTypePlaintext: CampaignBuilder{}.TypePlaintext(),
+		CampaignTypeABSplit:   CampaignBuilder{}.TypeABSplit(),
+		CampaignTypeRSS:       CampaignBuilder{}.TypeRSS(),
+		CampaignTypeVariate:   CampaignBuilder{}.TypeVariate(),
+	}
+	for expected, builder := range builders {
+		if builder.obj.Type != expected {
+			t.Errorf(
+				"expected type to be '%s' but was '%s'",
+				expected,
+				builder.obj.Type,
+			)
+		}
+	}
+}
+
+func TestCampaignContent_Validate(t *testing.T) {
+	valid := []CampaignContent{
+		NewHTMLCampaignContent("<p>Hello</p>", ""),
+		NewTemplateCampaignContent(12, map[string]string{"body": "<p>Hello</p>"}),
+		NewURLCampaignContent("https://test.com/newsletter.html"),
+		{PlainText: "Hello"},
+	}
+	for _, content := range valid {
+		if err := content.validate(); err != nil {
+			t.Errorf(
+				"expected no error to be returned for %+v, but got '%s'",
+				content,
+				err.Error(),
+			)
+		}
+	}
+	invalid := []CampaignContent{
+		{},
+		{HTML: "<p>Hello</p>", URL: "https://test.com/newsletter.html"},
+		{HTML: "<p>Hello</p>", Template: &CampaignContentTemplate{ID: 12}},
+	}
+	for _, content := range invalid {
+		if err := content.validate(); err == nil {
+			t.Errorf("expected error to be returned for %+v, but none was", content)
+		}
+	}
+}
+
+func TestCampaignBuilder_FolderID(t *testing.T) {
+	campaign, err := validCampaignBuilder().FolderID("folder-id").Build()
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if campaign.Settings.FolderID != "folder-id" {
+		t.Errorf("expected folder ID to be 'folder-id', but was '%s'", campaign.Settings.FolderID)
+	}
+}
+
+func TestCampaignBuilder_Flags(t *testing.T) {
+	campaign, _ := validCampaignBuilder().
+		UseConversation(true).
+		Authenticate(false).
+		AutoFooter(true).
+		InlineCSS(false).
+		Build()
+	settings := campaign.Settings
+	if settings.UseConversation == nil || !*settings.UseConversation {
+		t.Error("expected use conversation to be set to true")
+	}
+	if settings.Authenticate == nil || *settings.Authenticate {
+		t.Error("expected authenticate to be set to false")
+	}
+	if settings.AutoFooter == nil || !*settings.AutoFooter {
+		t.Error("expected auto footer to be set to true")
+	}
+	if settings.InlineCSS == nil || *settings.InlineCSS {
+		t.Error("expected inline CSS to be set to false")
+	}
+}
@@ -7,7 +7,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/url"
 	"strings"
+	"time"
 )
 
 type Client interface {
@@ -28,13 +31,52 @@ type Client interface {
 	// could not be completed.
 	FetchList(listID string) (List, error)
 	// UpdateList updates the fields of a given list to the ones
-	// passed in as a parameter. An error is returned if the request
-	// could not be completed.
+	// passed in as a parameter. Only the fields that are set are
+	// sent, fields that are empty or false are left as they are. An
+	// error is returned if the request could not be completed.
 	UpdateList(listID string, list List) (List, error)
+	// UpdateListFields updates only the fields set on the given
+	// ListUpdate, leaving the rest of the list as it is. Unlike
+	// UpdateList it can set fields to false or empty. An error is
+	// returned if the request could not be completed.
+	UpdateListFields(listID string, update ListUpdate) (List, error)
 	// DeleteList removes a list of the given ID from the MailChimp
 	// account. An error is returned if the request
 	// could not be completed.
 	DeleteList(listID string) error
+	// FetchListGrowthHistory returns the monthly growth of the list
+	// of the given ID, for up to 1000 months. An error is returned if
+	// the request could not be completed.
+	FetchListGrowthHistory(listID string) ([]ListGrowth, error)
+	// FetchListActivity returns the daily activity of the list of
+	// the given ID for up to the last 180 days. An error is returned
+	// if the request could not be completed.
+	FetchListActivity(listID string) ([]ListActivity, error)
+	// FetchListLocations returns the countries of the members of the
+	// list of the given ID. An error is returned if the request could
+	// not be completed.
+	FetchListLocations(listID string) ([]ListLocation, error)
+	// FetchListClients returns the email clients used by the members
+	// of the list of the given ID. An error is returned if the
+	// request could not be completed.
+	FetchListClients(listID string) ([]ListClient, error)
+	// FetchAbuseReports returns a page of the abuse reports for the
+	// list of the given ID. An error is returned if the request could
+	// not be completed.
+	FetchAbuseReports(listID string, page Page) (AbuseReportCollection, error)
+	// FetchAbuseReportMember returns the member who made the given
+	// abuse report. An error is returned if the request could not be
+	// completed.
+	FetchAbuseReportMember(report AbuseReport) (Member, error)
+	// FetchSignupForms returns the hosted signup forms of the list
+	// of the given ID. An error is returned if the request could not
+	// be completed.
+	FetchSignupForms(listID string) ([]SignupForm, error)
+	// CustomizeSignupForm updates the header, contents and styles of
+	// the hosted signup form of the list of the given ID, and returns
+	// the customized form. An error is returned if the request could
+	// not be completed.
+	CustomizeSignupForm(listID string, form SignupForm) (SignupForm, error)
 
 	// Batch adds up to 500 members at once to the list of a given
 	// ID. An error is returned if the request could not be
@@ -48,8 +90,28 @@ type Client interface {
 	// with only one request.
 	BatchOperations(operations OperationCollection) error
 
+	// FetchMarketingPermissionIDs returns the IDs of the marketing
+	// permissions set up for the list of the given ID, keyed by their
+	// text (e.g. "Email"). MailChimp only exposes the permissions on
+	// members, so an error is returned if the list has no members or
+	// if the request could not be completed.
+	FetchMarketingPermissionIDs(listID string) (map[string]string, error)
+
+	// SearchMembers searches for members by a full or partial email
+	// address or name. The search covers all lists on the account
+	// unless a list ID is given. An error is returned if the request
+	// could not be completed.
+	SearchMembers(query, listID string) (MemberSearchResults, error)
+
+	// FetchMember returns the member of the given list ID and email
+	// address. An error is returned if the request could not be
+	// completed.
+	FetchMember(listID, email string) (Member, error)
 	// UpdateMember is used to update information about a member such
-	// as their email address.
+	// as their email address. If the status of the member is set, the
+	// current member is fetched first and an error is returned without
+	// updating if MailChimp does not allow the status change, see
+	// MemberStatusAction.
 	UpdateMember(listID, email string, member Member) error
 
 	// FetchMemberTags returns all the member tags for a given
@@ -66,12 +128,286 @@ type Client interface {
 	// up for the tags will NOT be triggered when using this method.
 	// An error is returned if the request could not be completed.
 	UpdateMemberTagsSync(listID, memberEmail string, tags []Tag) error
+	// SearchListTags returns the tags on the list of the given ID
+	// whose names match the given prefix. An empty prefix returns
+	// all tags on the list. An error is returned if the request
+	// could not be completed.
+	SearchListTags(listID, prefix string) ([]ListTag, error)
+	// FetchMembersByTag returns an iterator over the members of the
+	// given list that have the tag of the given name. The members are
+	// fetched page by page while iterating. If the tag does not exist
+	// on the list or a request could not be completed, the error is
+	// returned by the iterators Err method.
+	FetchMembersByTag(listID, tagName string) *MemberIterator
+	// SetMemberTags makes the tags of a member match the given tag
+	// names. Missing tags are activated and any other tags on the
+	// member are deactivated, using a single UpdateMemberTags call.
+	// An error is returned if the request could not be completed.
+	SetMemberTags(listID, memberEmail string, desired []string) error
+	// SetMemberTagsWithPrefix works like SetMemberTags, but only
+	// manages tags whose names start with the given prefix, ignoring
+	// case. Tags without the prefix are left untouched, and an error is
+	// returned if a desired tag does not have the prefix.
+	SetMemberTagsWithPrefix(listID, memberEmail, prefix string, desired []string) error
+	// TagMembers adds (active) or removes (inactive) the tag of the
+	// given name for up to 500 members of a list at once. The tag
+	// is created if it does not already exist on the list. The
+	// outcome for each email address is returned, and an error is
+	// returned if the request could not be completed.
+	TagMembers(listID, tagName string, emails []string, active bool) ([]TagMemberResult, error)
+
+	// CreateMemberNote adds a note to the member of the given list
+	// ID and email address and returns the created note. An error
+	// is returned if the request could not be completed.
+	CreateMemberNote(listID, memberEmail, note string) (Note, error)
+	// FetchMemberNotes returns the notes for the member of the given
+	// list ID and email address. An error is returned if the request
+	// could not be completed.
+	FetchMemberNotes(listID, memberEmail string) ([]Note, error)
+	// UpdateMemberNote replaces the text of an existing note and
+	// returns the updated note. An error is returned if the request
+	// could not be completed.
+	UpdateMemberNote(listID, memberEmail string, noteID int, note string) (Note, error)
+	// DeleteMemberNote removes a note from a member. An error is
+	// returned if the request could not be completed.
+	DeleteMemberNote(listID, memberEmail string, noteID int) error
+
+	// CreateMemberEvent records a custom event, such as
+	// "trial_started", for the member of the given list ID and email
+	// address. Events can be used to trigger Customer Journeys. An
+	// error is returned if the request could not be completed.
+	CreateMemberEvent(listID, memberEmail string, event Event) error
+	// FetchMemberEvents returns the custom events recorded for the
+	// member of the given list ID and email address. An error is
+	// returned if the request could not be completed.
+	FetchMemberEvents(listID, memberEmail string) ([]Event, error)
+
+	// FetchMemberActivity returns the activity feed, such as opens,
+	// clicks and bounces, for the member of the given list ID and
+	// email address. The filters select the types of activity and
+	// the page of the feed. An error is returned if the request
+	// could not be completed.
+	FetchMemberActivity(listID, memberEmail string, filters MemberActivityFilters) (MemberActivityFeed, error)
 
 	// ArchiveMember archives a list member based on the given
 	// list ID and member email address. An error is returned if
 	// the request could not be completed.
 	ArchiveMember(listID, memberEmail string) error
 
+	// CreateCampaign creates a new campaign in MailChimp and returns
+	// the campaign with some updated fields such as ID.
+	CreateCampaign(Campaign) (Campaign, error)
+	// FetchCampaigns returns the campaigns of the MailChimp account
+	// matching the given filters. An error is returned if the request
+	// could not be completed.
+	FetchCampaigns(filters CampaignFilters) ([]Campaign, error)
+	// FetchCampaign returns the campaign of the given ID. An error is
+	// returned if the request could not be completed.
+	FetchCampaign(campaignID string) (Campaign, error)
+	// UpdateCampaign updates the recipients and settings of a given
+	// campaign to the ones passed in as a parameter. All settings are
+	// sent, so the campaign should be fetched and modified first. The
+	// recipients are only sent if a list ID is set. An error is
+	// returned if the request could not be completed.
+	UpdateCampaign(campaignID string, campaign Campaign) (Campaign, error)
+	// DeleteCampaign removes the campaign of the given ID from the
+	// MailChimp account. An error is returned if the request could
+	// not be completed.
+	DeleteCampaign(campaignID string) error
+	// CreateCampaignFolder creates a new campaign folder with the
+	// given name. An error is returned if the request could not be
+	// completed.
+	CreateCampaignFolder(name string) (CampaignFolder, error)
+	// FetchCampaignFolders returns a page of the campaign folders of
+	// the MailChimp account. An error is returned if the request could
+	// not be completed.
+	FetchCampaignFolders(page Page) ([]CampaignFolder, error)
+	// FetchCampaignFolder returns the campaign folder of the given ID.
+	// An error is returned if the request could not be completed.
+	FetchCampaignFolder(folderID string) (CampaignFolder, error)
+	// UpdateCampaignFolder renames the campaign folder of the given
+	// ID. An error is returned if the request could not be completed.
+	UpdateCampaignFolder(folderID, name string) (CampaignFolder, error)
+	// DeleteCampaignFolder deletes the campaign folder of the given
+	// ID. The campaigns in the folder are not deleted. An error is
+	// returned if the request could not be completed.
+	DeleteCampaignFolder(folderID string) error
+	// SetCampaignContent sets the content of the campaign of the given
+	// ID and returns the content as rendered by MailChimp. An error is
+	// returned if the content is invalid or if the request could not
+	// be completed.
+	SetCampaignContent(campaignID string, content CampaignContent) (RenderedCampaignContent, error)
+	// FetchCampaignContent returns the rendered HTML and plain text
+	// of the campaign of the given ID. An error is returned if the
+	// request could not be completed.
+	FetchCampaignContent(campaignID string) (RenderedCampaignContent, error)
+	// SendCampaign sends the campaign of the given ID right away. A
+	// CampaignNotReadyError is returned if MailChimp does not consider
+	// the campaign ready to send, and another error is returned if the
+	// request could not be completed.
+	SendCampaign(campaignID string) error
+	// SendCampaignWithChecklist fetches the send checklist of the
+	// campaign of the given ID before sending it. If the checklist
+	// has failing items, a CampaignNotReadyError holding the items is
+	// returned without sending the campaign.
+	SendCampaignWithChecklist(campaignID string) error
+	// FetchSendChecklist returns the send checklist of the campaign
+	// of the given ID. An error is returned if the request could not
+	// be completed.
+	FetchSendChecklist(campaignID string) (SendChecklist, error)
+	// SendTestEmail sends a test email of the campaign of the given ID
+	// to the given email addresses, using TestEmailTypeHTML or
+	// TestEmailTypePlaintext. An error is returned if the request
+	// could not be completed.
+	SendTestEmail(campaignID string, emails []string, sendType string) error
+	// ScheduleCampaign schedules the campaign of the given ID to be
+	// sent at a quarter-hour. With timewarp the campaign is sent at
+	// the given time in the time zone of each recipient, and the batch
+	// delivery is optional. An error is returned if the request could
+	// not be completed.
+	ScheduleCampaign(campaignID string, scheduleTime time.Time, timewarp bool, batchDelivery *BatchDelivery) error
+	// UnscheduleCampaign unschedules a scheduled campaign so that it
+	// can be edited. An error is returned if the request could not be
+	// completed.
+	UnscheduleCampaign(campaignID string) error
+	// PauseCampaign pauses an RSS campaign. An error is returned if
+	// the request could not be completed.
+	PauseCampaign(campaignID string) error
+	// ResumeCampaign resumes a paused RSS campaign. An error is
+	// returned if the request could not be completed.
+	ResumeCampaign(campaignID string) error
+	// ReplicateCampaign creates a copy of the campaign of the given
+	// ID and returns the copy. An error is returned if the request
+	// could not be completed.
+	ReplicateCampaign(campaignID string) (Campaign, error)
+	// CancelSend cancels a campaign that is currently being sent. An
+	// error is returned if the request could not be completed.
+	CancelSend(campaignID string) error
+
+	// FetchCampaignReport returns the summary statistics of the sent
+	// campaign of the given ID. An error is returned if the request
+	// could not be completed.
+	FetchCampaignReport(campaignID string) (CampaignReport, error)
+	// FetchReportClickDetails returns a page of the links in the
+	// campaign and the clicks on each of them. An error is returned
+	// if the request could not be completed.
+	FetchReportClickDetails(campaignID string, page Page) (ClickDetailCollection, error)
+	// FetchReportOpenDetails returns a page of the members who opened
+	// the campaign. An error is returned if the request could not be
+	// completed.
+	FetchReportOpenDetails(campaignID string, page Page) (OpenDetailCollection, error)
+	// FetchReportEmailActivity returns a page of the actions each
+	// member has taken on the campaign. An error is returned if the
+	// request could not be completed.
+	FetchReportEmailActivity(campaignID string, page Page) (EmailActivityCollection, error)
+	// StreamEmailActivity returns an iterator over the email activity
+	// of every member the campaign was sent to, fetching one page at a
+	// time. A non-zero since only includes activity after that time,
+	// for incremental syncing. Errors are reported by the Err method
+	// of the iterator.
+	StreamEmailActivity(campaignID string, since time.Time) *EmailActivityIterator
+	// FetchReportUnsubscribes returns a page of the members who
+	// unsubscribed through the campaign. An error is returned if the
+	// request could not be completed.
+	FetchReportUnsubscribes(campaignID string, page Page) (UnsubscribeCollection, error)
+	// FetchReportSentTo returns a page of the members the campaign was
+	// sent to. An error is returned if the request could not be
+	// completed.
+	FetchReportSentTo(campaignID string, page Page) (SentToCollection, error)
+	// FetchReportDomainPerformance returns the statistics of the
+	// campaign for the most common email domains. An error is returned
+	// if the request could not be completed.
+	FetchReportDomainPerformance(campaignID string) ([]DomainPerformance, error)
+	// FetchReportLocations returns a page of the regions the campaign
+	// was opened from. An error is returned if the request could not
+	// be completed.
+	FetchReportLocations(campaignID string, page Page) (OpenLocationCollection, error)
+
+	// CreateTemplate creates a new template and returns it. An error
+	// is returned if the request could not be completed.
+	CreateTemplate(Template) (Template, error)
+	// FetchTemplates returns the templates of the MailChimp account
+	// matching the given filters. An error is returned if the request
+	// could not be completed.
+	FetchTemplates(filters TemplateFilters) ([]Template, error)
+	// FetchTemplate returns the template of the given ID. An error is
+	// returned if the request could not be completed.
+	FetchTemplate(templateID int) (Template, error)
+	// UpdateTemplate updates the name, folder and HTML of the template
+	// of the given ID. Fields left empty are not changed. An error is
+	// returned if the request could not be completed.
+	UpdateTemplate(templateID int, template Template) (Template, error)
+	// DeleteTemplate deletes the template of the given ID. An error is
+	// returned if the request could not be completed.
+	DeleteTemplate(templateID int) error
+	// FetchTemplateDefaultContent returns the editable sections of the
+	// template of the given ID. An error is returned if the request
+	// could not be completed.
+	FetchTemplateDefaultContent(templateID int) (TemplateDefaultContent, error)
+	// SyncTemplate creates a user template with the name of the given
+	// template, or updates it if one already exists. An error is
+	// returned if more than one template has the name, or if the
+	// request could not be completed.
+	SyncTemplate(template Template) (Template, error)
+	// SyncTemplateFromFile reads the HTML file at the given path and
+	// syncs it to the user template of the given name, like
+	// SyncTemplate. An error is returned if the file could not be
+	// read or the request could not be completed.
+	SyncTemplateFromFile(name, path string) (Template, error)
+	// CreateTemplateFolder creates a new template folder with the
+	// given name. An error is returned if the request could not be
+	// completed.
+	CreateTemplateFolder(name string) (TemplateFolder, error)
+	// FetchTemplateFolders returns a page of the template folders of
+	// the MailChimp account. An error is returned if the request could
+	// not be completed.
+	FetchTemplateFolders(page Page) ([]TemplateFolder, error)
+	// FetchTemplateFolder returns the template folder of the given ID.
+	// An error is returned if the request could not be completed.
+	FetchTemplateFolder(folderID string) (TemplateFolder, error)
+	// UpdateTemplateFolder renames the template folder of the given
+	// ID. An error is returned if the request could not be completed.
+	UpdateTemplateFolder(folderID, name string) (TemplateFolder, error)
+	// DeleteTemplateFolder deletes the template folder of the given
+	// ID. The templates in the folder are not deleted. An error is
+	// returned if the request could not be completed.
+	DeleteTemplateFolder(folderID string) error
+
+	// FetchAutomations returns a page of the classic automations of the
+	// MailChimp account. An error is returned if the request could not
+	// be completed.
+	FetchAutomations(page Page) ([]Automation, error)
+	// FetchAutomation returns the classic automation of the given ID.
+	// An error is returned if the request could not be completed.
+	FetchAutomation(workflowID string) (Automation, error)
+	// StartAutomation starts all emails of the classic automation of
+	// the given ID. An error is returned if the request could not be
+	// completed.
+	StartAutomation(workflowID string) error
+	// PauseAutomation pauses all emails of the classic automation of
+	// the given ID. An error is returned if the request could not be
+	// completed.
+	PauseAutomation(workflowID string) error
+	// FetchAutomationEmails returns the emails of the classic
+	// automation of the given ID. An error is returned if the request
+	// could not be completed.
+	FetchAutomationEmails(workflowID string) ([]AutomationEmail, error)
+	// AddSubscriberToAutomationQueue adds the member with the given
+	// email address to the queue of an automation email, which sends
+	// the email to the member regardless of the trigger. An error is
+	// returned if the request could not be completed.
+	AddSubscriberToAutomationQueue(workflowID, workflowEmailID, memberEmail string) error
+	// RemoveSubscriberFromAutomation removes the member with the given
+	// email address from the classic automation of the given ID. The
+	// member can not be added to the automation again. An error is
+	// returned if the request could not be completed.
+	RemoveSubscriberFromAutomation(workflowID, memberEmail string) error
+
+	// TriggerJourneyStep triggers the API step of the customer journey
+	// of the given ID for the member with the given email address. An
+	// error is returned if the request could not be completed.
+	TriggerJourneyStep(journeyID, stepID int, memberEmail string) error
+
 	// CreateWebhook creates a new Webhook and returns an error
 	// if the request could not be completed.
 	CreateWebhook(webhook Webhook) (Webhook, error)
@@ -164,9 +500,17 @@ func (c client) FetchList(id string) (List, error) {
 }
 
 func (c client) UpdateList(id string, l List) (List, error) {
+	return c.patchList(id, newListPayload(l))
+}
+
+func (c client) UpdateListFields(id string, update ListUpdate) (List, error) {
+	return c.patchList(id, update)
+}
+
+func (c client) patchList(id string, payload interface{}) (List, error) {
 	body, err := c.provider.Patch(
 		fmt.Sprintf("/lists/%s", id),
-		l,
+		payload,
 	)
 	if err != nil {
 		return NullList, err
@@ -185,10 +529,138 @@ func (c client) DeleteList(id string) error {
 	return err
 }
 
+func (c client) FetchListGrowthHistory(id string) ([]ListGrowth, error) {
+	query := url.Values{}
+	Page{Count: 1000}.addTo(query)
+	body, err := c.provider.Get(
+		withQuery(fmt.Sprintf("/lists/%s/growth-history", id), query),
+	)
+	if err != nil {
+		return nil, err
+	}
+	collection := listGrowthCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return nil, err
+	}
+	return collection.History, nil
+}
+
+func (c client) FetchListActivity(id string) ([]ListActivity, error) {
+	query := url.Values{}
+	Page{Count: 180}.addTo(query)
+	body, err := c.provider.Get(
+		withQuery(fmt.Sprintf("/lists/%s/activity", id), query),
+	)
+	if err != nil {
+		return nil, err
+	}
+	collection := listActivityCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return nil, err
+	}
+	return collection.Activity, nil
+}
+
+func (c client) FetchListLocations(id string) ([]ListLocation, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf("/lists/%s/locations", id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	collection := listLocationCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return nil, err
+	}
+	return collection.Locations, nil
+}
+
+func (c client) FetchListClients(id string) ([]ListClient, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf("/lists/%s/clients", id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	collection := listClientCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return nil, err
+	}
+	return collection.Clients, nil
+}
+
+func (c client) FetchAbuseReports(id string, page Page) (AbuseReportCollection, error) {
+	query := url.Values{}
+	page.addTo(query)
+	body, err := c.provider.Get(
+		withQuery(fmt.Sprintf("/lists/%s/abuse-reports", id), query),
+	)
+	if err != nil {
+		return AbuseReportCollection{}, err
+	}
+	collection := AbuseReportCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return AbuseReportCollection{}, err
+	}
+	return collection, nil
+}
+
+func (c client) FetchAbuseReportMember(report AbuseReport) (Member, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf(
+			"/lists/%s/members/%s",
+			report.ListID,
+			report.EmailID,
+		),
+	)
+	if err != nil {
+		return NullMember, err
+	}
+	member := Member{}
+	if err := json.Unmarshal(body, &member); err != nil {
+		return NullMember, err
+	}
+	return member, nil
+}
+
+func (c client) FetchSignupForms(id string) ([]SignupForm, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf("/lists/%s/signup-forms", id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	collection := signupFormCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return nil, err
+	}
+	return collection.SignupForms, nil
+}
+
+func (c client) CustomizeSignupForm(id string, form SignupForm) (SignupForm, error) {
+	body, err := c.provider.Post(
+		fmt.Sprintf("/lists/%s/signup-forms", id),
+		signupFormPayload{
+			Header:   form.Header,
+			Contents: form.Contents,
+			Styles:   form.Styles,
+		},
+	)
+	if err != nil {
+		return NullSignupForm, err
+	}
+	customized := SignupForm{}
+	if err := json.Unmarshal(body, &customized); err != nil {
+		return NullSignupForm, err
+	}
+	return customized, nil
+}
+
 type batchedMember struct {
-	EmailAddress string            `json:"email_address"`
-	Status       string            `json:"status"`
-	MergeFields  map[string]string `json:"merge_fields"`
+	EmailAddress         string                       `json:"email_address"`
+	Status               MemberStatus                 `json:"status"`
+	MergeFields          MergeFields                  `json:"merge_fields"`
+	MarketingPermissions []marketingPermissionPayload `json:"marketing_permissions,omitempty"`
 }
 
 type batch struct {
@@ -208,12 +680,20 @@ func (c client) batch(id string, members []Member, update bool) error {
 	if len(members) > 500 {
 		return errors.New("batch operation only allows for a maximum of 500 members")
 	}
+	// The current statuses are unknown, so only transitions that are
+	// never allowed, such as setting a member as cleaned, are caught.
+	for _, member := range members {
+		if _, err := MemberStatusAction("", member.Status); err != nil {
+			return fmt.Errorf("invalid member %s: %w", member.EmailAddress, err)
+		}
+	}
 	data := make([]batchedMember, 0)
 	for _, member := range members {
 		data = append(data, batchedMember{
-			EmailAddress: member.EmailAddress,
-			Status:       member.Status,
-			MergeFields:  member.MergeFields,
+			EmailAddress:         member.EmailAddress,
+			Status:               member.Status,
+			MergeFields:          member.MergeFields,
+			MarketingPermissions: newMarketingPermissionPayloads(member.MarketingPermissions),
 		})
 	}
 	_, err := c.provider.Post(fmt.Sprintf("/lists/%s", id), batch{
@@ -238,14 +718,41 @@ func (c client) BatchOperations(operations OperationCollection) error {
 	return nil
 }
 
+func (c client) FetchMember(listID, email string) (Member, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf(
+			"/lists/%s/members/%s",
+			listID,
+			hashMd5(strings.ToLower(email)),
+		),
+	)
+	if err != nil {
+		return NullMember, err
+	}
+	member := Member{}
+	if err := json.Unmarshal(body, &member); err != nil {
+		return NullMember, err
+	}
+	return member, nil
+}
+
 func (c client) UpdateMember(listID, email string, member Member) error {
+	if member.Status != "" {
+		current, err := c.fetchMemberStatus(listID, email)
+		if err != nil {
+			return err
+		}
+		if _, err := MemberStatusAction(current, member.Status); err != nil {
+			return err
+		}
+	}
 	_, err := c.provider.Patch(
 		fmt.Sprintf(
 			"/lists/%s/members/%s",
 			listID,
 			hashMd5(strings.ToLower(email)),
 		),
-		member,
+		newMemberPayload(member),
 	)
 	if err != nil {
 		return err
@@ -253,10 +760,88 @@ func (c client) UpdateMember(listID, email string, member Member) error {
 	return nil
 }
 
+// fetchMemberStatus fetches only the status of a member, so that the
+// member does not have to be decoded in full.
+func (c client) fetchMemberStatus(listID, email string) (MemberStatus, error) {
+	query := url.Values{}
+	query.Set("fields", "status")
+	body, err := c.provider.Get(
+		withQuery(
+			fmt.Sprintf(
+				"/lists/%s/members/%s",
+				listID,
+				hashMd5(strings.ToLower(email)),
+			),
+			query,
+		),
+	)
+	if err != nil {
+		return "", err
+	}
+	current := struct {
+		Status MemberStatus `json:"status"`
+	}{}
+	if err := json.Unmarshal(body, &current); err != nil {
+		return "", err
+	}
+	return current.Status, nil
+}
+
+func (c client) FetchMarketingPermissionIDs(listID string) (map[string]string, error) {
+	query := url.Values{}
+	query.Set("count", "1")
+	query.Set("fields", "members.marketing_permissions")
+	body, err := c.provider.Get(
+		withQuery(fmt.Sprintf("/lists/%s/members", listID), query),
+	)
+	if err != nil {
+		return nil, err
+	}
+	collection := memberCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return nil, err
+	}
+	if len(collection.Members) == 0 {
+		return nil, errors.New(
+			"could not fetch marketing permissions since the list has no members",
+		)
+	}
+	ids := make(map[string]string)
+	for _, permission := range collection.Members[0].MarketingPermissions {
+		ids[permission.Text] = permission.MarketingPermissionID
+	}
+	return ids, nil
+}
+
+func (c client) SearchMembers(query, listID string) (MemberSearchResults, error) {
+	values := url.Values{}
+	values.Set("query", query)
+	if listID != "" {
+		values.Set("list_id", listID)
+	}
+	body, err := c.provider.Get(withQuery("/search-members", values))
+	if err != nil {
+		return MemberSearchResults{}, err
+	}
+	response := memberSearchResponse{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return MemberSearchResults{}, err
+	}
+	return MemberSearchResults{
+		ExactMatches: response.ExactMatches.Members,
+		FullSearch:   response.FullSearch.Members,
+	}, nil
+}
+
 type memberTagsResponse struct {
-	Tags []Tag `json:"tags"`
+	Tags       []Tag `json:"tags"`
+	TotalItems int   `json:"total_items"`
 }
 
+// memberTagsPageSize is the number of tags fetched per request when
+// paging through all the tags of a member.
+const memberTagsPageSize = 1000
+
 func (c client) FetchMemberTags(listID, memberEmail string) ([]Tag, error) {
 	tags := memberTagsResponse{}
 	body, err := c.provider.Get(
@@ -313,86 +898,1152 @@ func (c client) UpdateMemberTagsSync(listID, memberEmail string, tags []Tag) err
 	return err
 }
 
-func (c client) ArchiveMember(listID, memberEmail string) error {
-	_, err := c.provider.Delete(
-		fmt.Sprintf(
-			"/lists/%s/members/%s",
-			listID,
-			hashMd5(strings.ToLower(memberEmail)),
-		),
-	)
-	return err
+func (c client) FetchMembersByTag(listID, tagName string) *MemberIterator {
+	tag, found, err := c.findListTag(listID, tagName)
+	if err != nil {
+		return &MemberIterator{pager: pager{err: err}}
+	}
+	if !found {
+		return &MemberIterator{
+			pager: pager{
+				err: fmt.Errorf("could not find tag '%s' on list %s", tagName, listID),
+			},
+		}
+	}
+	return newMemberIterator(func(page Page) ([]Member, int, error) {
+		query := url.Values{}
+		page.addTo(query)
+		body, err := c.provider.Get(
+			withQuery(
+				fmt.Sprintf("/lists/%s/segments/%d/members", listID, tag.ID),
+				query,
+			),
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		collection := memberCollection{}
+		if err := json.Unmarshal(body, &collection); err != nil {
+			return nil, 0, err
+		}
+		return collection.Members, collection.TotalItems, nil
+	})
 }
 
-type CreateWebhookRequestPayload struct {
-	URL     string         `json:"url"`
-	Events  WebhookEvents  `json:"events"`
-	Sources WebhookSources `json:"sources"`
+func (c client) SetMemberTags(listID, memberEmail string, desired []string) error {
+	return c.setMemberTags(listID, memberEmail, "", desired)
 }
 
-func (c client) CreateWebhook(webhook Webhook) (Webhook, error) {
-	body, err := c.provider.Post(
-		fmt.Sprintf("/lists/%s/webhooks", webhook.ListID),
-		CreateWebhookRequestPayload{
-			URL:     webhook.URL,
-			Events:  webhook.Events,
-			Sources: webhook.Sources,
-		},
-	)
+func (c client) SetMemberTagsWithPrefix(listID, memberEmail, prefix string, desired []string) error {
+	return c.setMemberTags(listID, memberEmail, prefix, desired)
+}
+
+func (c client) setMemberTags(listID, memberEmail, prefix string, desired []string) error {
+	for _, name := range desired {
+		if !hasTagPrefix(name, prefix) {
+			return fmt.Errorf(
+				"tag '%s' does not have the managed prefix '%s'",
+				name,
+				prefix,
+			)
+		}
+	}
+	current, err := c.fetchAllMemberTags(listID, memberEmail)
 	if err != nil {
-		return NullWebhook, err
+		return err
 	}
-	createdWebhook := Webhook{}
-	if err := json.Unmarshal(body, &createdWebhook); err != nil {
-		return NullWebhook, err
+	tags := diffMemberTags(current, prefix, desired)
+	if len(tags) == 0 {
+		return nil
 	}
-	return createdWebhook, nil
+	return c.UpdateMemberTags(listID, memberEmail, tags)
 }
 
-func (c client) FetchWebhooks(listID string) ([]Webhook, error) {
+// fetchAllMemberTags pages through every tag of a member, since
+// FetchMemberTags only returns the first page.
+func (c client) fetchAllMemberTags(listID, memberEmail string) ([]Tag, error) {
+	tags := make([]Tag, 0)
+	page := Page{Count: memberTagsPageSize}
+	for {
+		query := url.Values{}
+		page.addTo(query)
+		body, err := c.provider.Get(
+			withQuery(
+				fmt.Sprintf(
+					"/lists/%s/members/%s/tags",
+					listID,
+					hashMd5(strings.ToLower(memberEmail)),
+				),
+				query,
+			),
+		)
+		if err != nil {
+			return nil, err
+		}
+		response := memberTagsResponse{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, err
+		}
+		tags = append(tags, response.Tags...)
+		page.Offset += len(response.Tags)
+		if len(response.Tags) == 0 || page.Offset >= response.TotalItems {
+			return tags, nil
+		}
+	}
+}
+
+// hasTagPrefix reports whether the tag name starts with the prefix.
+// Tag names are case-insensitive in MailChimp, so the prefix is too.
+func hasTagPrefix(name, prefix string) bool {
+	return strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix))
+}
+
+func diffMemberTags(current []Tag, prefix string, desired []string) []Tag {
+	wanted := make(map[string]bool)
+	for _, name := range desired {
+		wanted[strings.ToLower(name)] = true
+	}
+	existing := make(map[string]bool)
+	tags := make([]Tag, 0)
+	for _, tag := range current {
+		existing[strings.ToLower(tag.Name)] = true
+		if hasTagPrefix(tag.Name, prefix) && !wanted[strings.ToLower(tag.Name)] {
+			tags = append(tags, Tag{Name: tag.Name, Status: tagStatusInactive})
+		}
+	}
+	for _, name := range desired {
+		if !existing[strings.ToLower(name)] {
+			existing[strings.ToLower(name)] = true
+			tags = append(tags, Tag{Name: name, Status: tagStatusActive})
+		}
+	}
+	return tags
+}
+
+func (c client) SearchListTags(listID, prefix string) ([]ListTag, error) {
+	query := url.Values{}
+	if prefix != "" {
+		query.Set("name", prefix)
+	}
 	body, err := c.provider.Get(
-		fmt.Sprintf("/lists/%s/webhooks", listID),
+		withQuery(fmt.Sprintf("/lists/%s/tag-search", listID), query),
 	)
 	if err != nil {
 		return nil, err
 	}
-	collection := webhookCollection{}
+	collection := listTagCollection{}
 	if err := json.Unmarshal(body, &collection); err != nil {
 		return nil, err
 	}
-	return collection.Webhooks, nil
+	tags := make([]ListTag, 0, len(collection.Tags))
+	for _, tag := range collection.Tags {
+		if hasTagPrefix(tag.Name, prefix) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
 }
 
-func (c client) FetchWebhook(listID, webhookID string) (Webhook, error) {
-	body, err := c.provider.Get(
-		fmt.Sprintf(
-			"/lists/%s/webhooks/%s",
-			listID,
-			webhookID,
-		),
-	)
-	if err != nil {
-		return NullWebhook, err
-	}
-	webhook := Webhook{}
-	if err := json.Unmarshal(body, &webhook); err != nil {
-		return NullWebhook, err
-	}
-	return webhook, nil
+type createStaticSegmentPayload struct {
+	Name          string   `json:"name"`
+	StaticSegment []string `json:"static_segment"`
 }
 
-func (c client) DeleteWebhook(listID, webhookID string) error {
-	_, err := c.provider.Delete(
-		fmt.Sprintf(
-			"/lists/%s/webhooks/%s",
-			listID,
-			webhookID,
-		),
-	)
-	return err
+type segmentMembersPayload struct {
+	MembersToAdd    []string `json:"members_to_add"`
+	MembersToRemove []string `json:"members_to_remove"`
 }
 
-func authorization(key string) string {
+type segmentMembersError struct {
+	EmailAddresses []string `json:"email_addresses"`
+	Error          string   `json:"error"`
+}
+
+type segmentMembersResponse struct {
+	TotalAdded   int                   `json:"total_added"`
+	TotalRemoved int                   `json:"total_removed"`
+	ErrorCount   int                   `json:"error_count"`
+	Errors       []segmentMembersError `json:"errors"`
+}
+
+func (c client) TagMembers(listID, tagName string, emails []string, active bool) ([]TagMemberResult, error) {
+	if len(emails) > 500 {
+		return nil, errors.New("tagging members only allows for a maximum of 500 members")
+	}
+	tag, found, err := c.findListTag(listID, tagName)
+	if err != nil {
+		return nil, err
+	}
+	if !found && !active {
+		return tagMemberResults(emails, nil), nil
+	}
+	if !found {
+		body, err := c.provider.Post(
+			fmt.Sprintf("/lists/%s/segments", listID),
+			createStaticSegmentPayload{
+				Name:          tagName,
+				StaticSegment: []string{},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(body, &tag); err != nil {
+			return nil, err
+		}
+	}
+	payload := segmentMembersPayload{
+		MembersToAdd:    []string{},
+		MembersToRemove: []string{},
+	}
+	if active {
+		payload.MembersToAdd = emails
+	} else {
+		payload.MembersToRemove = emails
+	}
+	body, err := c.provider.Post(
+		fmt.Sprintf("/lists/%s/segments/%d", listID, tag.ID),
+		payload,
+	)
+	if err != nil {
+		return nil, err
+	}
+	response := segmentMembersResponse{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	return tagMemberResults(emails, response.Errors), nil
+}
+
+func (c client) findListTag(listID, tagName string) (ListTag, bool, error) {
+	tags, err := c.SearchListTags(listID, tagName)
+	if err != nil {
+		return ListTag{}, false, err
+	}
+	for _, tag := range tags {
+		if strings.EqualFold(tag.Name, tagName) {
+			return tag, true, nil
+		}
+	}
+	return ListTag{}, false, nil
+}
+
+func tagMemberResults(emails []string, failures []segmentMembersError) []TagMemberResult {
+	errorsByEmail := make(map[string]string)
+	for _, failure := range failures {
+		for _, email := range failure.EmailAddresses {
+			errorsByEmail[strings.ToLower(email)] = failure.Error
+		}
+	}
+	results := make([]TagMemberResult, 0, len(emails))
+	for _, email := range emails {
+		results = append(results, TagMemberResult{
+			EmailAddress: email,
+			Error:        errorsByEmail[strings.ToLower(email)],
+		})
+	}
+	return results
+}
+
+func (c client) CreateMemberNote(listID, memberEmail, note string) (Note, error) {
+	body, err := c.provider.Post(
+		fmt.Sprintf(
+			"/lists/%s/members/%s/notes",
+			listID,
+			hashMd5(strings.ToLower(memberEmail)),
+		),
+		notePayload{Note: note},
+	)
+	if err != nil {
+		return NullNote, err
+	}
+	created := Note{}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return NullNote, err
+	}
+	return created, nil
+}
+
+func (c client) FetchMemberNotes(listID, memberEmail string) ([]Note, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf(
+			"/lists/%s/members/%s/notes",
+			listID,
+			hashMd5(strings.ToLower(memberEmail)),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	collection := noteCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return nil, err
+	}
+	return collection.Notes, nil
+}
+
+func (c client) UpdateMemberNote(listID, memberEmail string, noteID int, note string) (Note, error) {
+	body, err := c.provider.Patch(
+		fmt.Sprintf(
+			"/lists/%s/members/%s/notes/%d",
+			listID,
+			hashMd5(strings.ToLower(memberEmail)),
+			noteID,
+		),
+		notePayload{Note: note},
+	)
+	if err != nil {
+		return NullNote, err
+	}
+	updated := Note{}
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return NullNote, err
+	}
+	return updated, nil
+}
+
+func (c client) DeleteMemberNote(listID, memberEmail string, noteID int) error {
+	_, err := c.provider.Delete(
+		fmt.Sprintf(
+			"/lists/%s/members/%s/notes/%d",
+			listID,
+			hashMd5(strings.ToLower(memberEmail)),
+			noteID,
+		),
+	)
+	return err
+}
+
+func (c client) CreateMemberEvent(listID, memberEmail string, event Event) error {
+	_, err := c.provider.Post(
+		fmt.Sprintf(
+			"/lists/%s/members/%s/events",
+			listID,
+			hashMd5(strings.ToLower(memberEmail)),
+		),
+		event,
+	)
+	return err
+}
+
+func (c client) FetchMemberEvents(listID, memberEmail string) ([]Event, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf(
+			"/lists/%s/members/%s/events",
+			listID,
+			hashMd5(strings.ToLower(memberEmail)),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	collection := eventCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return nil, err
+	}
+	return collection.Events, nil
+}
+
+func (c client) FetchMemberActivity(listID, memberEmail string, filters MemberActivityFilters) (MemberActivityFeed, error) {
+	query := url.Values{}
+	if len(filters.ActivityTypes) > 0 {
+		query.Set("activity_filters", strings.Join(filters.ActivityTypes, ","))
+	}
+	filters.Page.addTo(query)
+	body, err := c.provider.Get(
+		withQuery(
+			fmt.Sprintf(
+				"/lists/%s/members/%s/activity-feed",
+				listID,
+				hashMd5(strings.ToLower(memberEmail)),
+			),
+			query,
+		),
+	)
+	if err != nil {
+		return NullMemberActivityFeed, err
+	}
+	feed := MemberActivityFeed{}
+	if err := json.Unmarshal(body, &feed); err != nil {
+		return NullMemberActivityFeed, err
+	}
+	return feed, nil
+}
+
+func (c client) ArchiveMember(listID, memberEmail string) error {
+	_, err := c.provider.Delete(
+		fmt.Sprintf(
+			"/lists/%s/members/%s",
+			listID,
+			hashMd5(strings.ToLower(memberEmail)),
+		),
+	)
+	return err
+}
+
+func (c client) CreateCampaign(campaign Campaign) (Campaign, error) {
+	body, err := c.provider.Post(
+		"/campaigns",
+		createCampaignPayload{
+			Type:       campaign.Type,
+			Recipients: campaign.Recipients,
+			Settings:   campaign.Settings,
+		},
+	)
+	if err != nil {
+		return NullCampaign, err
+	}
+	created := Campaign{}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return NullCampaign, err
+	}
+	return created, nil
+}
+
+func (c client) FetchCampaigns(filters CampaignFilters) ([]Campaign, error) {
+	query := url.Values{}
+	if filters.Type != "" {
+		query.Set("type", filters.Type)
+	}
+	if filters.Status != "" {
+		query.Set("status", filters.Status)
+	}
+	if filters.ListID != "" {
+		query.Set("list_id", filters.ListID)
+	}
+	if filters.FolderID != "" {
+		query.Set("folder_id", filters.FolderID)
+	}
+	filters.Page.addTo(query)
+	body, err := c.provider.Get(withQuery("/campaigns", query))
+	if err != nil {
+		return NullCampaignSlice, err
+	}
+	collection := campaignCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return NullCampaignSlice, err
+	}
+	return collection.Campaigns, nil
+}
+
+func (c client) FetchCampaign(id string) (Campaign, error) {
+	body, err := c.provider.Get(fmt.Sprintf("/campaigns/%s", id))
+	if err != nil {
+		return NullCampaign, err
+	}
+	campaign := Campaign{}
+	if err := json.Unmarshal(body, &campaign); err != nil {
+		return NullCampaign, err
+	}
+	return campaign, nil
+}
+
+func (c client) UpdateCampaign(id string, campaign Campaign) (Campaign, error) {
+	body, err := c.provider.Patch(
+		fmt.Sprintf("/campaigns/%s", id),
+		newUpdateCampaignPayload(campaign),
+	)
+	if err != nil {
+		return NullCampaign, err
+	}
+	updated := Campaign{}
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return NullCampaign, err
+	}
+	return updated, nil
+}
+
+func (c client) DeleteCampaign(id string) error {
+	_, err := c.provider.Delete(
+		fmt.Sprintf("/campaigns/%s", id),
+	)
+	return err
+}
+
+func (c client) CreateCampaignFolder(name string) (CampaignFolder, error) {
+	body, err := c.provider.Post(
+		"/campaign-folders",
+		campaignFolderPayload{Name: name},
+	)
+	if err != nil {
+		return NullCampaignFolder, err
+	}
+	folder := CampaignFolder{}
+	if err := json.Unmarshal(body, &folder); err != nil {
+		return NullCampaignFolder, err
+	}
+	return folder, nil
+}
+
+func (c client) FetchCampaignFolders(page Page) ([]CampaignFolder, error) {
+	query := url.Values{}
+	page.addTo(query)
+	body, err := c.provider.Get(withQuery("/campaign-folders", query))
+	if err != nil {
+		return NullCampaignFolderSlice, err
+	}
+	collection := campaignFolderCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return NullCampaignFolderSlice, err
+	}
+	return collection.Folders, nil
+}
+
+func (c client) FetchCampaignFolder(id string) (CampaignFolder, error) {
+	body, err := c.provider.Get(fmt.Sprintf("/campaign-folders/%s", id))
+	if err != nil {
+		return NullCampaignFolder, err
+	}
+	folder := CampaignFolder{}
+	if err := json.Unmarshal(body, &folder); err != nil {
+		return NullCampaignFolder, err
+	}
+	return folder, nil
+}
+
+func (c client) UpdateCampaignFolder(id, name string) (CampaignFolder, error) {
+	body, err := c.provider.Patch(
+		fmt.Sprintf("/campaign-folders/%s", id),
+		campaignFolderPayload{Name: name},
+	)
+	if err != nil {
+		return NullCampaignFolder, err
+	}
+	folder := CampaignFolder{}
+	if err := json.Unmarshal(body, &folder); err != nil {
+		return NullCampaignFolder, err
+	}
+	return folder, nil
+}
+
+func (c client) DeleteCampaignFolder(id string) error {
+	_, err := c.provider.Delete(
+		fmt.Sprintf("/campaign-folders/%s", id),
+	)
+	return err
+}
+
+func (c client) SetCampaignContent(id string, content CampaignContent) (RenderedCampaignContent, error) {
+	if err := content.validate(); err != nil {
+		return NullRenderedCampaignContent, err
+	}
+	body, err := c.provider.Put(
+		fmt.Sprintf("/campaigns/%s/content", id),
+		content,
+	)
+	if err != nil {
+		return NullRenderedCampaignContent, err
+	}
+	rendered := RenderedCampaignContent{}
+	if err := json.Unmarshal(body, &rendered); err != nil {
+		return NullRenderedCampaignContent, err
+	}
+	return rendered, nil
+}
+
+func (c client) FetchCampaignContent(id string) (RenderedCampaignContent, error) {
+	body, err := c.provider.Get(fmt.Sprintf("/campaigns/%s/content", id))
+	if err != nil {
+		return NullRenderedCampaignContent, err
+	}
+	rendered := RenderedCampaignContent{}
+	if err := json.Unmarshal(body, &rendered); err != nil {
+		return NullRenderedCampaignContent, err
+	}
+	return rendered, nil
+}
+
+type sendTestEmailPayload struct {
+	TestEmails []string `json:"test_emails"`
+	SendType   string   `json:"send_type"`
+}
+
+type scheduleCampaignPayload struct {
+	ScheduleTime  string         `json:"schedule_time"`
+	Timewarp      bool           `json:"timewarp"`
+	BatchDelivery *BatchDelivery `json:"batch_delivery,omitempty"`
+}
+
+func (c client) SendCampaign(id string) error {
+	err := c.campaignAction(id, "send", struct{}{})
+	return campaignNotReady(id, err)
+}
+
+func (c client) SendCampaignWithChecklist(id string) error {
+	checklist, err := c.FetchSendChecklist(id)
+	if err != nil {
+		return err
+	}
+	if !checklist.IsReady() {
+		return &CampaignNotReadyError{
+			CampaignID: id,
+			Detail:     "the send checklist has failing items",
+			Items:      checklist.FailingItems(),
+		}
+	}
+	return c.SendCampaign(id)
+}
+
+func (c client) FetchSendChecklist(id string) (SendChecklist, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf("/campaigns/%s/send-checklist", id),
+	)
+	if err != nil {
+		return NullSendChecklist, err
+	}
+	checklist := SendChecklist{}
+	if err := json.Unmarshal(body, &checklist); err != nil {
+		return NullSendChecklist, err
+	}
+	return checklist, nil
+}
+
+func (c client) SendTestEmail(id string, emails []string, sendType string) error {
+	if sendType != TestEmailTypeHTML && sendType != TestEmailTypePlaintext {
+		return fmt.Errorf("invalid test email type '%s'", sendType)
+	}
+	err := c.campaignAction(id, "test", sendTestEmailPayload{
+		TestEmails: emails,
+		SendType:   sendType,
+	})
+	return campaignNotReady(id, err)
+}
+
+func (c client) ScheduleCampaign(id string, scheduleTime time.Time, timewarp bool, batchDelivery *BatchDelivery) error {
+	if err := validateScheduleTime(scheduleTime); err != nil {
+		return err
+	}
+	err := c.campaignAction(id, "schedule", scheduleCampaignPayload{
+		ScheduleTime:  scheduleTime.UTC().Format(time.RFC3339),
+		Timewarp:      timewarp,
+		BatchDelivery: batchDelivery,
+	})
+	return campaignNotReady(id, err)
+}
+
+func (c client) UnscheduleCampaign(id string) error {
+	return c.campaignAction(id, "unschedule", struct{}{})
+}
+
+func (c client) PauseCampaign(id string) error {
+	return c.campaignAction(id, "pause", struct{}{})
+}
+
+func (c client) ResumeCampaign(id string) error {
+	return c.campaignAction(id, "resume", struct{}{})
+}
+
+func (c client) ReplicateCampaign(id string) (Campaign, error) {
+	body, err := c.provider.Post(
+		fmt.Sprintf("/campaigns/%s/actions/replicate", id),
+		struct{}{},
+	)
+	if err != nil {
+		return NullCampaign, err
+	}
+	replica := Campaign{}
+	if err := json.Unmarshal(body, &replica); err != nil {
+		return NullCampaign, err
+	}
+	return replica, nil
+}
+
+func (c client) CancelSend(id string) error {
+	return c.campaignAction(id, "cancel-send", struct{}{})
+}
+
+func (c client) campaignAction(id, action string, payload interface{}) error {
+	_, err := c.provider.Post(
+		fmt.Sprintf("/campaigns/%s/actions/%s", id, action),
+		payload,
+	)
+	return err
+}
+
+func (c client) FetchCampaignReport(id string) (CampaignReport, error) {
+	body, err := c.provider.Get(fmt.Sprintf("/reports/%s", id))
+	if err != nil {
+		return NullCampaignReport, err
+	}
+	report := CampaignReport{}
+	if err := json.Unmarshal(body, &report); err != nil {
+		return NullCampaignReport, err
+	}
+	return report, nil
+}
+
+func (c client) FetchReportClickDetails(id string, page Page) (ClickDetailCollection, error) {
+	collection := ClickDetailCollection{}
+	if err := c.fetchReport(id, "click-details", page, &collection); err != nil {
+		return ClickDetailCollection{}, err
+	}
+	return collection, nil
+}
+
+func (c client) FetchReportOpenDetails(id string, page Page) (OpenDetailCollection, error) {
+	collection := OpenDetailCollection{}
+	if err := c.fetchReport(id, "open-details", page, &collection); err != nil {
+		return OpenDetailCollection{}, err
+	}
+	return collection, nil
+}
+
+func (c client) FetchReportEmailActivity(id string, page Page) (EmailActivityCollection, error) {
+	collection := EmailActivityCollection{}
+	if err := c.fetchReport(id, "email-activity", page, &collection); err != nil {
+		return EmailActivityCollection{}, err
+	}
+	return collection, nil
+}
+
+func (c client) StreamEmailActivity(id string, since time.Time) *EmailActivityIterator {
+	return newEmailActivityIterator(func(page Page) ([]EmailActivity, int, error) {
+		query := url.Values{}
+		page.addTo(query)
+		if !since.IsZero() {
+			query.Set("since", since.UTC().Format(time.RFC3339))
+		}
+		body, err := c.provider.Get(
+			withQuery(fmt.Sprintf("/reports/%s/email-activity", id), query),
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		collection := EmailActivityCollection{}
+		if err := json.Unmarshal(body, &collection); err != nil {
+			return nil, 0, err
+		}
+		return collection.Emails, collection.TotalItems, nil
+	})
+}
+
+func (c client) FetchReportUnsubscribes(id string, page Page) (UnsubscribeCollection, error) {
+	collection := UnsubscribeCollection{}
+	if err := c.fetchReport(id, "unsubscribed", page, &collection); err != nil {
+		return UnsubscribeCollection{}, err
+	}
+	return collection, nil
+}
+
+func (c client) FetchReportSentTo(id string, page Page) (SentToCollection, error) {
+	collection := SentToCollection{}
+	if err := c.fetchReport(id, "sent-to", page, &collection); err != nil {
+		return SentToCollection{}, err
+	}
+	return collection, nil
+}
+
+func (c client) FetchReportDomainPerformance(id string) ([]DomainPerformance, error) {
+	collection := domainPerformanceCollection{}
+	if err := c.fetchReport(id, "domain-performance", Page{}, &collection); err != nil {
+		return nil, err
+	}
+	return collection.Domains, nil
+}
+
+func (c client) FetchReportLocations(id string, page Page) (OpenLocationCollection, error) {
+	collection := OpenLocationCollection{}
+	if err := c.fetchReport(id, "locations", page, &collection); err != nil {
+		return OpenLocationCollection{}, err
+	}
+	return collection, nil
+}
+
+// fetchReport fetches a page of the given sub-report of a campaign
+// and decodes it into v.
+func (c client) fetchReport(id, report string, page Page, v interface{}) error {
+	query := url.Values{}
+	page.addTo(query)
+	body, err := c.provider.Get(
+		withQuery(fmt.Sprintf("/reports/%s/%s", id, report), query),
+	)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+func (c client) CreateTemplate(template Template) (Template, error) {
+	body, err := c.provider.Post(
+		"/templates",
+		newTemplatePayload(template),
+	)
+	if err != nil {
+		return NullTemplate, err
+	}
+	created := Template{}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return NullTemplate, err
+	}
+	return created, nil
+}
+
+func (c client) FetchTemplates(filters TemplateFilters) ([]Template, error) {
+	collection, err := c.fetchTemplates(filters)
+	if err != nil {
+		return NullTemplateSlice, err
+	}
+	return collection.Templates, nil
+}
+
+func (c client) fetchTemplates(filters TemplateFilters) (templateCollection, error) {
+	query := url.Values{}
+	if filters.Type != "" {
+		query.Set("type", filters.Type)
+	}
+	if filters.Category != "" {
+		query.Set("category", filters.Category)
+	}
+	if filters.FolderID != "" {
+		query.Set("folder_id", filters.FolderID)
+	}
+	filters.Page.addTo(query)
+	body, err := c.provider.Get(withQuery("/templates", query))
+	if err != nil {
+		return templateCollection{}, err
+	}
+	collection := templateCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return templateCollection{}, err
+	}
+	return collection, nil
+}
+
+func (c client) FetchTemplate(id int) (Template, error) {
+	body, err := c.provider.Get(fmt.Sprintf("/templates/%d", id))
+	if err != nil {
+		return NullTemplate, err
+	}
+	template := Template{}
+	if err := json.Unmarshal(body, &template); err != nil {
+		return NullTemplate, err
+	}
+	return template, nil
+}
+
+func (c client) UpdateTemplate(id int, template Template) (Template, error) {
+	body, err := c.provider.Patch(
+		fmt.Sprintf("/templates/%d", id),
+		newTemplatePayload(template),
+	)
+	if err != nil {
+		return NullTemplate, err
+	}
+	updated := Template{}
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return NullTemplate, err
+	}
+	return updated, nil
+}
+
+func (c client) DeleteTemplate(id int) error {
+	_, err := c.provider.Delete(
+		fmt.Sprintf("/templates/%d", id),
+	)
+	return err
+}
+
+func (c client) FetchTemplateDefaultContent(id int) (TemplateDefaultContent, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf("/templates/%d/default-content", id),
+	)
+	if err != nil {
+		return NullTemplateDefaultContent, err
+	}
+	content := TemplateDefaultContent{}
+	if err := json.Unmarshal(body, &content); err != nil {
+		return NullTemplateDefaultContent, err
+	}
+	return content, nil
+}
+
+func (c client) SyncTemplate(template Template) (Template, error) {
+	matches, err := c.findUserTemplates(template.Name)
+	if err != nil {
+		return NullTemplate, err
+	}
+	switch len(matches) {
+	case 0:
+		return c.CreateTemplate(template)
+	case 1:
+		return c.UpdateTemplate(matches[0].ID, template)
+	}
+	return NullTemplate, fmt.Errorf(
+		"could not sync template, found %d templates named '%s'",
+		len(matches),
+		template.Name,
+	)
+}
+
+func (c client) SyncTemplateFromFile(name, path string) (Template, error) {
+	html, err := ioutil.ReadFile(path)
+	if err != nil {
+		return NullTemplate, err
+	}
+	template, err := TemplateBuilder{}.
+		Name(name).
+		HTML(string(html)).
+		Build()
+	if err != nil {
+		return NullTemplate, err
+	}
+	return c.SyncTemplate(template)
+}
+
+// findUserTemplates pages through the user templates of the account and
+// returns the ones with the given name, since MailChimp cannot filter
+// templates by name.
+func (c client) findUserTemplates(name string) ([]Template, error) {
+	matches := make([]Template, 0)
+	filters := TemplateFilters{
+		Type: TemplateTypeUser,
+		Page: Page{Count: templatePageSize},
+	}
+	for {
+		collection, err := c.fetchTemplates(filters)
+		if err != nil {
+			return nil, err
+		}
+		for _, template := range collection.Templates {
+			if template.Name == name {
+				matches = append(matches, template)
+			}
+		}
+		filters.Page.Offset += len(collection.Templates)
+		if len(collection.Templates) == 0 || filters.Page.Offset >= collection.TotalItems {
+			return matches, nil
+		}
+	}
+}
+
+func (c client) CreateTemplateFolder(name string) (TemplateFolder, error) {
+	body, err := c.provider.Post(
+		"/template-folders",
+		templateFolderPayload{Name: name},
+	)
+	if err != nil {
+		return NullTemplateFolder, err
+	}
+	folder := TemplateFolder{}
+	if err := json.Unmarshal(body, &folder); err != nil {
+		return NullTemplateFolder, err
+	}
+	return folder, nil
+}
+
+func (c client) FetchTemplateFolders(page Page) ([]TemplateFolder, error) {
+	query := url.Values{}
+	page.addTo(query)
+	body, err := c.provider.Get(withQuery("/template-folders", query))
+	if err != nil {
+		return NullTemplateFolderSlice, err
+	}
+	collection := templateFolderCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return NullTemplateFolderSlice, err
+	}
+	return collection.Folders, nil
+}
+
+func (c client) FetchTemplateFolder(id string) (TemplateFolder, error) {
+	body, err := c.provider.Get(fmt.Sprintf("/template-folders/%s", id))
+	if err != nil {
+		return NullTemplateFolder, err
+	}
+	folder := TemplateFolder{}
+	if err := json.Unmarshal(body, &folder); err != nil {
+		return NullTemplateFolder, err
+	}
+	return folder, nil
+}
+
+func (c client) UpdateTemplateFolder(id, name string) (TemplateFolder, error) {
+	body, err := c.provider.Patch(
+		fmt.Sprintf("/template-folders/%s", id),
+		templateFolderPayload{Name: name},
+	)
+	if err != nil {
+		return NullTemplateFolder, err
+	}
+	folder := TemplateFolder{}
+	if err := json.Unmarshal(body, &folder); err != nil {
+		return NullTemplateFolder, err
+	}
+	return folder, nil
+}
+
+func (c client) DeleteTemplateFolder(id string) error {
+	_, err := c.provider.Delete(
+		fmt.Sprintf("/template-folders/%s", id),
+	)
+	return err
+}
+
+func (c client) FetchAutomations(page Page) ([]Automation, error) {
+	query := url.Values{}
+	page.addTo(query)
+	body, err := c.provider.Get(withQuery("/automations", query))
+	if err != nil {
+		return NullAutomationSlice, err
+	}
+	collection := automationCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return NullAutomationSlice, err
+	}
+	return collection.Automations, nil
+}
+
+func (c client) FetchAutomation(id string) (Automation, error) {
+	body, err := c.provider.Get(fmt.Sprintf("/automations/%s", id))
+	if err != nil {
+		return NullAutomation, err
+	}
+	automation := Automation{}
+	if err := json.Unmarshal(body, &automation); err != nil {
+		return NullAutomation, err
+	}
+	return automation, nil
+}
+
+func (c client) StartAutomation(id string) error {
+	_, err := c.provider.Post(
+		fmt.Sprintf("/automations/%s/actions/start-all-emails", id),
+		struct{}{},
+	)
+	return err
+}
+
+func (c client) PauseAutomation(id string) error {
+	_, err := c.provider.Post(
+		fmt.Sprintf("/automations/%s/actions/pause-all-emails", id),
+		struct{}{},
+	)
+	return err
+}
+
+func (c client) FetchAutomationEmails(id string) ([]AutomationEmail, error) {
+	body, err := c.provider.Get(fmt.Sprintf("/automations/%s/emails", id))
+	if err != nil {
+		return NullAutomationEmailSlice, err
+	}
+	collection := automationEmailCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return NullAutomationEmailSlice, err
+	}
+	return collection.Emails, nil
+}
+
+func (c client) AddSubscriberToAutomationQueue(workflowID, workflowEmailID, memberEmail string) error {
+	_, err := c.provider.Post(
+		fmt.Sprintf(
+			"/automations/%s/emails/%s/queue",
+			workflowID,
+			workflowEmailID,
+		),
+		automationSubscriberPayload{
+			EmailAddress: strings.ToLower(memberEmail),
+		},
+	)
+	return err
+}
+
+func (c client) RemoveSubscriberFromAutomation(workflowID, memberEmail string) error {
+	_, err := c.provider.Post(
+		fmt.Sprintf("/automations/%s/removed-subscribers", workflowID),
+		automationSubscriberPayload{
+			EmailAddress: strings.ToLower(memberEmail),
+		},
+	)
+	return err
+}
+
+type journeyTriggerPayload struct {
+	EmailAddress string `json:"email_address"`
+}
+
+func (c client) TriggerJourneyStep(journeyID, stepID int, memberEmail string) error {
+	_, err := c.provider.Post(
+		fmt.Sprintf(
+			"/customer-journeys/journeys/%d/steps/%d/actions/trigger",
+			journeyID,
+			stepID,
+		),
+		journeyTriggerPayload{
+			EmailAddress: strings.ToLower(memberEmail),
+		},
+	)
+	return err
+}
+
+type CreateWebhookRequestPayload struct {
+	URL     string         `json:"url"`
+	Events  WebhookEvents  `json:"events"`
+	Sources WebhookSources `json:"sources"`
+}
+
+func (c client) CreateWebhook(webhook Webhook) (Webhook, error) {
+	body, err := c.provider.Post(
+		fmt.Sprintf("/lists/%s/webhooks", webhook.ListID),
+		CreateWebhookRequestPayload{
+			URL:     webhook.URL,
+			Events:  webhook.Events,
+			Sources: webhook.Sources,
+		},
+	)
+	if err != nil {
+		return NullWebhook, err
+	}
+	createdWebhook := Webhook{}
+	if err := json.Unmarshal(body, &createdWebhook); err != nil {
+		return NullWebhook, err
+	}
+	return createdWebhook, nil
+}
+
+func (c client) FetchWebhooks(listID string) ([]Webhook, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf("/lists/%s/webhooks", listID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	collection := webhookCollection{}
+	if err := json.Unmarshal(body, &collection); err != nil {
+		return nil, err
+	}
+	return collection.Webhooks, nil
+}
+
+func (c client) FetchWebhook(listID, webhookID string) (Webhook, error) {
+	body, err := c.provider.Get(
+		fmt.Sprintf(
+			"/lists/%s/webhooks/%s",
+			listID,
+			webhookID,
+		),
+	)
+	if err != nil {
+		return NullWebhook, err
+	}
+	webhook := Webhook{}
+	if err := json.Unmarshal(body, &webhook); err != nil {
+		return NullWebhook, err
+	}
+	return webhook, nil
+}
+
+func (c client) DeleteWebhook(listID, webhookID string) error {
+	_, err := c.provider.Delete(
+		fmt.Sprintf(
+			"/lists/%s/webhooks/%s",
+			listID,
+			webhookID,
+		),
+	)
+	return err
+}
+
+func authorization(key string) string {
 	method := "Basic"
 	k := base64.
 		StdEncoding.
@@ -404,6 +2055,13 @@ func authorization(key string) string {
 	return fmt.Sprintf("%s %s", method, k)
 }
 
+func withQuery(uri string, query url.Values) string {
+	if len(query) == 0 {
+		return uri
+	}
+	return fmt.Sprintf("%s?%s", uri, query.Encode())
+}
+
 func hashMd5(data string) string {
 	h := md5.Sum([]byte(data))
 	return hex.EncodeToString(h[:])
@@ -1,9 +1,14 @@
 package mailchimp
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestAthorization(t *testing.T) {
@@ -388,7 +393,7 @@ func TestClient_UpdateListCallsProviderWithCorrectParams(t *testing.T) {
 					s,
 				)
 			}
-			payload := i.(List)
+			payload := i.(listPayload)
 			if payload.Name != "Test" {
 				t.Errorf(
 					"expected list name to be 'Test', but was '%s'",
@@ -406,12 +411,84 @@ func TestClient_UpdateListCallsProviderWithCorrectParams(t *testing.T) {
 	}
 	client := NewCustomDependencyClient(&mock)
 	list := List{
+		ID:                 testListId,
 		Name:               "Test",
 		PermissionReminder: "This is a test",
 	}
 	client.UpdateList(testListId, list)
 }
 
+func TestClient_UpdateListSendsFieldsChangedAfterBuild(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			raw, _ := json.Marshal(i)
+			payload := map[string]json.RawMessage{}
+			json.Unmarshal(raw, &payload)
+			if string(payload["double_optin"]) != "true" {
+				t.Errorf("expected double_optin to be true, but was '%s'", payload["double_optin"])
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	list, _ := ListBuilder{}.Name("Test").Build()
+	list.DoubleOptin = true
+	client.UpdateList("test-id", list)
+}
+
+func TestClient_UpdateListLeavesOutFieldsNotSet(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			raw, _ := json.Marshal(i)
+			payload := map[string]json.RawMessage{}
+			json.Unmarshal(raw, &payload)
+			if string(payload["name"]) != "\"Test\"" {
+				t.Errorf("expected name to be 'Test', but was '%s'", payload["name"])
+			}
+			for _, key := range []string{"contact", "campaign_defaults", "permission_reminder", "double_optin", "use_archive_bar", "id", "date_created"} {
+				if _, ok := payload[key]; ok {
+					t.Errorf("expected field '%s' to not be sent, but got %s", key, raw)
+				}
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	client.UpdateList("test-id", List{ID: "abc", Name: "Test", DateCreated: "2021-03-01"})
+}
+
+func TestClient_UpdateListFieldsOnlySendsFieldsSetOnBuilder(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/lists/test-id" {
+				t.Errorf("expected uri to be /lists/test-id, but was %s", s)
+			}
+			raw, _ := json.Marshal(i)
+			payload := map[string]json.RawMessage{}
+			json.Unmarshal(raw, &payload)
+			if len(payload) != 2 {
+				t.Errorf("expected 2 fields to be sent, but got %s", raw)
+			}
+			if string(payload["double_optin"]) != "false" {
+				t.Errorf("expected double_optin to be false, but was '%s'", payload["double_optin"])
+			}
+			if string(payload["visibility"]) != "\"prv\"" {
+				t.Errorf("expected visibility to be 'prv', but was '%s'", payload["visibility"])
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	update, err := ListBuilder{}.DoubleOptin(false).VisibilityPrivate().BuildUpdate()
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	client.UpdateListFields("test-id", update)
+	if mock.PatchCalls != 1 {
+		t.Errorf("expected provider Patch() to have been called once, was called %d times", mock.PatchCalls)
+	}
+}
+
 func TestClient_FetchListCallsProviderWithCorrectParams(t *testing.T) {
 	testListId := "test-id"
 	mock := MailChimpProviderMock{
@@ -792,3 +869,2001 @@ func TestClient_DeleteWebhookReturnsErrorIfProviderFails(t *testing.T) {
 		t.Error("expected error to be returned but none was")
 	}
 }
+
+func TestClient_SearchListTagsCallsProviderWithCorrectParams(t *testing.T) {
+	expectedListID := "list-id"
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != fmt.Sprintf("/lists/%s/tag-search?name=beta", expectedListID) {
+				t.Errorf(
+					"expected uri to be /lists/%s/tag-search?name=beta, but was %s",
+					expectedListID,
+					s,
+				)
+			}
+			return []byte("{\"tags\":[{\"id\":1,\"name\":\"beta-users\"},{\"id\":2,\"name\":\"not-beta\"}]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	tags, err := client.SearchListTags(expectedListID, "beta")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(tags) != 1 || tags[0].Name != "beta-users" {
+		t.Errorf("expected only tag 'beta-users' to be returned, but got %v", tags)
+	}
+}
+
+func TestClient_TagMembersCreatesMissingTag(t *testing.T) {
+	expectedListID := "list-id"
+	emails := []string{"a@test.com", "B@test.com"}
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"tags\":[]}"), nil
+		},
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			switch s {
+			case fmt.Sprintf("/lists/%s/segments", expectedListID):
+				payload := i.(createStaticSegmentPayload)
+				if payload.Name != "beta" {
+					t.Errorf("expected segment name to be 'beta', but was '%s'", payload.Name)
+				}
+				return []byte("{\"id\":42,\"name\":\"beta\"}"), nil
+			case fmt.Sprintf("/lists/%s/segments/42", expectedListID):
+				payload := i.(segmentMembersPayload)
+				if len(payload.MembersToAdd) != 2 || len(payload.MembersToRemove) != 0 {
+					t.Errorf("expected 2 members to add and none to remove, but got %v", payload)
+				}
+				return []byte("{\"total_added\":1,\"errors\":[{\"email_addresses\":[\"b@test.com\"],\"error\":\"not a member\"}]}"), nil
+			}
+			t.Errorf("unexpected uri %s", s)
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	results, err := client.TagMembers(expectedListID, "beta", emails, true)
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if mock.PostCalls != 2 {
+		t.Errorf("expected provider Post() to have been called twice, was called %d times", mock.PostCalls)
+	}
+	if len(results) != 2 || !results[0].Successful() || results[1].Error != "not a member" {
+		t.Errorf("expected only second result to have failed, but got %v", results)
+	}
+}
+
+func TestClient_TagMembersRemovingUnknownTagDoesNotPost(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"tags\":[]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	results, err := client.TagMembers("list-id", "beta", []string{"a@test.com"}, false)
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(results) != 1 || !results[0].Successful() {
+		t.Errorf("expected one successful result, but got %v", results)
+	}
+	if mock.PostCalls != 0 {
+		t.Errorf("expected provider Post() to not have been called, was called %d times", mock.PostCalls)
+	}
+}
+
+func TestClient_TagMembers500Limit(t *testing.T) {
+	mock := MailChimpProviderMock{}
+	client := NewCustomDependencyClient(&mock)
+	emails := make([]string, 501)
+	if _, err := client.TagMembers("list-id", "beta", emails, true); err == nil {
+		t.Error("expected error to be returned with more than 500 emails, no error was returned")
+	}
+	if mock.GetCalls > 0 || mock.PostCalls > 0 {
+		t.Error("expected provider to not have been called")
+	}
+}
+
+func TestClient_SetMemberTagsSendsDiff(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"tags\":[{\"name\":\"keep\"},{\"name\":\"drop\"}]}"), nil
+		},
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			payload := i.(updateMemberTagsPayload)
+			expected := map[string]string{
+				"drop": tagStatusInactive,
+				"new":  tagStatusActive,
+			}
+			if len(payload.Tags) != len(expected) {
+				t.Errorf("expected %d tags to be sent, but got %v", len(expected), payload.Tags)
+			}
+			for _, tag := range payload.Tags {
+				if expected[tag.Name] != tag.Status {
+					t.Errorf(
+						"expected tag '%s' to have status '%s', but was '%s'",
+						tag.Name,
+						expected[tag.Name],
+						tag.Status,
+					)
+				}
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.SetMemberTags("list-id", "test@test.com", []string{"keep", "new"}); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if mock.PostCalls != 1 {
+		t.Errorf("expected provider Post() to have been called once, was called %d times", mock.PostCalls)
+	}
+}
+
+func TestClient_SetMemberTagsWithoutDiffDoesNotPost(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"tags\":[{\"name\":\"keep\"}]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.SetMemberTags("list-id", "test@test.com", []string{"keep"}); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if mock.PostCalls != 0 {
+		t.Errorf("expected provider Post() to not have been called, was called %d times", mock.PostCalls)
+	}
+}
+
+func TestClient_SetMemberTagsWithPrefixLeavesOtherTags(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"tags\":[{\"name\":\"crm:old\"},{\"name\":\"other-system\"}]}"), nil
+		},
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			payload := i.(updateMemberTagsPayload)
+			for _, tag := range payload.Tags {
+				if tag.Name == "other-system" {
+					t.Error("expected tag without prefix to be left untouched")
+				}
+			}
+			if len(payload.Tags) != 2 {
+				t.Errorf("expected 2 tags to be sent, but got %v", payload.Tags)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	err := client.SetMemberTagsWithPrefix("list-id", "test@test.com", "crm:", []string{"crm:new"})
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_SetMemberTagsPagesThroughAllTags(t *testing.T) {
+	memberID := hashMd5("test@test.com")
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			switch s {
+			case fmt.Sprintf("/lists/list-id/members/%s/tags?count=1000", memberID):
+				return []byte("{\"tags\":[{\"name\":\"keep\"},{\"name\":\"first-page\"}],\"total_items\":3}"), nil
+			case fmt.Sprintf("/lists/list-id/members/%s/tags?count=1000&offset=2", memberID):
+				return []byte("{\"tags\":[{\"name\":\"second-page\"}],\"total_items\":3}"), nil
+			}
+			t.Errorf("unexpected uri %s", s)
+			return nil, nil
+		},
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			payload := i.(updateMemberTagsPayload)
+			expected := map[string]string{
+				"first-page":  tagStatusInactive,
+				"second-page": tagStatusInactive,
+			}
+			if len(payload.Tags) != len(expected) {
+				t.Errorf("expected %d tags to be sent, but got %v", len(expected), payload.Tags)
+			}
+			for _, tag := range payload.Tags {
+				if expected[tag.Name] != tag.Status {
+					t.Errorf(
+						"expected tag '%s' to have status '%s', but was '%s'",
+						tag.Name,
+						expected[tag.Name],
+						tag.Status,
+					)
+				}
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.SetMemberTags("list-id", "test@test.com", []string{"keep"}); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if mock.GetCalls != 2 {
+		t.Errorf("expected provider Get() to have been called twice, was called %d times", mock.GetCalls)
+	}
+}
+
+func TestClient_SetMemberTagsWithPrefixIsCaseInsensitive(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"tags\":[{\"name\":\"CRM:old\"},{\"name\":\"crm:keep\"}]}"), nil
+		},
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			payload := i.(updateMemberTagsPayload)
+			if len(payload.Tags) != 1 || payload.Tags[0].Name != "CRM:old" || payload.Tags[0].Status != tagStatusInactive {
+				t.Errorf("expected only 'CRM:old' to be deactivated, but got %v", payload.Tags)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	err := client.SetMemberTagsWithPrefix("list-id", "test@test.com", "crm:", []string{"Crm:Keep"})
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_SetMemberTagsWithPrefixRejectsUnprefixedTags(t *testing.T) {
+	mock := MailChimpProviderMock{}
+	client := NewCustomDependencyClient(&mock)
+	err := client.SetMemberTagsWithPrefix("list-id", "test@test.com", "crm:", []string{"other"})
+	if err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+	if mock.GetCalls != 0 {
+		t.Errorf("expected provider Get() to not have been called, was called %d times", mock.GetCalls)
+	}
+}
+
+func TestClient_CreateMemberNoteCallsProviderWithCorrectParams(t *testing.T) {
+	expectedListID := "list-id"
+	expectedMemberID := hashMd5("test@test.com")
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != fmt.Sprintf("/lists/%s/members/%s/notes", expectedListID, expectedMemberID) {
+				t.Errorf(
+					"expected uri to be /lists/%s/members/%s/notes, but was %s",
+					expectedListID,
+					expectedMemberID,
+					s,
+				)
+			}
+			payload := i.(notePayload)
+			if payload.Note != "called about invoice" {
+				t.Errorf("expected note to be 'called about invoice', but was '%s'", payload.Note)
+			}
+			return []byte("{\"id\":7,\"note\":\"called about invoice\"}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	note, err := client.CreateMemberNote(expectedListID, "Test@test.com", "called about invoice")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if note.ID != 7 {
+		t.Errorf("expected note ID to be 7, but was %d", note.ID)
+	}
+}
+
+func TestClient_FetchMemberNotesCallsProviderWithCorrectParams(t *testing.T) {
+	expectedListID := "list-id"
+	expectedMemberID := hashMd5("test@test.com")
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != fmt.Sprintf("/lists/%s/members/%s/notes", expectedListID, expectedMemberID) {
+				t.Errorf(
+					"expected uri to be /lists/%s/members/%s/notes, but was %s",
+					expectedListID,
+					expectedMemberID,
+					s,
+				)
+			}
+			return []byte("{\"notes\":[{\"id\":1},{\"id\":2}],\"total_items\":2}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	notes, err := client.FetchMemberNotes(expectedListID, "test@test.com")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(notes) != 2 {
+		t.Errorf("expected 2 notes to be returned, but got %d", len(notes))
+	}
+}
+
+func TestClient_UpdateMemberNoteCallsProviderWithCorrectParams(t *testing.T) {
+	expectedListID := "list-id"
+	expectedMemberID := hashMd5("test@test.com")
+	mock := MailChimpProviderMock{
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			if s != fmt.Sprintf("/lists/%s/members/%s/notes/7", expectedListID, expectedMemberID) {
+				t.Errorf(
+					"expected uri to be /lists/%s/members/%s/notes/7, but was %s",
+					expectedListID,
+					expectedMemberID,
+					s,
+				)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	client.UpdateMemberNote(expectedListID, "test@test.com", 7, "updated")
+}
+
+func TestClient_DeleteMemberNoteReturnsErrorIfProviderFails(t *testing.T) {
+	mock := MailChimpProviderMock{
+		DeleteMock: func(s string) ([]byte, error) {
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.DeleteMemberNote("list-id", "test@test.com", 7); err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+}
+
+func TestClient_CreateMemberEventCallsProviderWithCorrectParams(t *testing.T) {
+	expectedListID := "list-id"
+	expectedMemberID := hashMd5("test@test.com")
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != fmt.Sprintf("/lists/%s/members/%s/events", expectedListID, expectedMemberID) {
+				t.Errorf(
+					"expected uri to be /lists/%s/members/%s/events, but was %s",
+					expectedListID,
+					expectedMemberID,
+					s,
+				)
+			}
+			payload := i.(Event)
+			if payload.Name != "trial_started" {
+				t.Errorf("expected event name to be 'trial_started', but was '%s'", payload.Name)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	client.CreateMemberEvent(expectedListID, "test@test.com", Event{Name: "trial_started"})
+}
+
+func TestClient_FetchMemberEventsReturnsErrorIfProviderFails(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.FetchMemberEvents("list-id", "test@test.com"); err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+}
+
+func TestClient_FetchMemberActivityCallsProviderWithCorrectParams(t *testing.T) {
+	expectedListID := "list-id"
+	expectedMemberID := hashMd5("test@test.com")
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			expectedURI := fmt.Sprintf(
+				"/lists/%s/members/%s/activity-feed?activity_filters=open%%2Cbounce&count=50&offset=100",
+				expectedListID,
+				expectedMemberID,
+			)
+			if s != expectedURI {
+				t.Errorf("expected uri to be %s, but was %s", expectedURI, s)
+			}
+			return []byte("{\"activity\":[{\"activity_type\":\"open\",\"created_at_timestamp\":\"2021-03-01T12:00:00+00:00\"}],\"total_items\":101}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	feed, err := client.FetchMemberActivity(expectedListID, "test@test.com", MemberActivityFilters{
+		ActivityTypes: []string{ActivityTypeOpen, ActivityTypeBounce},
+		Page:          Page{Count: 50, Offset: 100},
+	})
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(feed.Activity) != 1 || feed.Activity[0].ActivityType != ActivityTypeOpen {
+		t.Errorf("expected one open activity to be returned, but got %v", feed.Activity)
+	}
+	if feed.TotalItems != 101 {
+		t.Errorf("expected total items to be 101, but was %d", feed.TotalItems)
+	}
+}
+
+func TestClient_FetchMemberActivityReturnsErrorIfProviderFails(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	_, err := client.FetchMemberActivity("list-id", "test@test.com", MemberActivityFilters{})
+	if err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+}
+
+func TestClient_SearchMembersCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/search-members?list_id=list-id&query=smith" {
+				t.Errorf(
+					"expected uri to be /search-members?list_id=list-id&query=smith, but was %s",
+					s,
+				)
+			}
+			return []byte("{\"exact_matches\":{\"members\":[]},\"full_search\":{\"members\":[{\"email_address\":\"john@smith.com\",\"merge_fields\":{\"ADDRESS\":{\"addr1\":\"Main St 1\"},\"AGE\":42}}]}}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	results, err := client.SearchMembers("smith", "list-id")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(results.ExactMatches) != 0 {
+		t.Errorf("expected no exact matches, but got %d", len(results.ExactMatches))
+	}
+	if len(results.FullSearch) != 1 || results.FullSearch[0].EmailAddress != "john@smith.com" {
+		t.Errorf("expected john@smith.com to be found, but got %v", results.FullSearch)
+	}
+}
+
+func TestClient_SearchMembersWithoutListID(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/search-members?query=smith" {
+				t.Errorf("expected uri to be /search-members?query=smith, but was %s", s)
+			}
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.SearchMembers("smith", ""); err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+}
+
+func TestClient_UpdateMemberSendsWritableFields(t *testing.T) {
+	expectedListID := "list-id"
+	expectedMemberID := hashMd5("old@test.com")
+	mock := MailChimpProviderMock{
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			if s != fmt.Sprintf("/lists/%s/members/%s", expectedListID, expectedMemberID) {
+				t.Errorf(
+					"expected uri to be /lists/%s/members/%s, but was %s",
+					expectedListID,
+					expectedMemberID,
+					s,
+				)
+			}
+			payload := i.(memberPayload)
+			if payload.EmailAddress != "new@test.com" {
+				t.Errorf("expected email address to be 'new@test.com', but was '%s'", payload.EmailAddress)
+			}
+			if payload.Language != "sv" {
+				t.Errorf("expected language to be 'sv', but was '%s'", payload.Language)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	member := Member{
+		ID:           "read-only-id",
+		EmailAddress: "new@test.com",
+		Language:     "sv",
+		MemberRating: 4,
+	}
+	client.UpdateMember(expectedListID, "Old@test.com", member)
+	if mock.PatchCalls != 1 {
+		t.Errorf("expected provider Patch() to have been called once, was called %d times", mock.PatchCalls)
+	}
+}
+
+func TestClient_UpdateMemberIgnoresMergeFieldsOfCurrentMember(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"status\":\"unsubscribed\",\"merge_fields\":{\"ADDRESS\":{\"addr1\":\"Main St 1\"},\"AGE\":42}}"), nil
+		},
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	member := Member{EmailAddress: "test@test.com", Status: StatusPending}
+	if err := client.UpdateMember("list-id", "test@test.com", member); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_FetchMemberDecodesAddressMergeField(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"email_address\":\"test@test.com\",\"merge_fields\":{\"FNAME\":\"Test\",\"AGE\":42,\"ADDRESS\":{\"addr1\":\"Main St 1\",\"city\":\"Stockholm\",\"zip\":\"11122\",\"country\":\"SE\"}}}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	member, err := client.FetchMember("list-id", "test@test.com")
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if member.MergeFields.String("FNAME") != "Test" {
+		t.Errorf("expected FNAME to be 'Test', but was '%s'", member.MergeFields.String("FNAME"))
+	}
+	if member.MergeFields.String("AGE") != "42" {
+		t.Errorf("expected AGE to be '42', but was '%s'", member.MergeFields.String("AGE"))
+	}
+	address, ok := member.MergeFields.Address("ADDRESS")
+	if !ok || address.City != "Stockholm" || address.Addr1 != "Main St 1" {
+		t.Errorf("expected ADDRESS to be decoded, but got %+v", address)
+	}
+}
+
+func TestClient_FetchMarketingPermissionIDs(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/lists/list-id/members?count=1&fields=members.marketing_permissions" {
+				t.Errorf(
+					"expected uri to be /lists/list-id/members?count=1&fields=members.marketing_permissions, but was %s",
+					s,
+				)
+			}
+			return []byte("{\"members\":[{\"marketing_permissions\":[{\"marketing_permission_id\":\"p1\",\"text\":\"Email\"}]}]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	ids, err := client.FetchMarketingPermissionIDs("list-id")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if ids["Email"] != "p1" {
+		t.Errorf("expected ID of 'Email' to be 'p1', but was '%s'", ids["Email"])
+	}
+}
+
+func TestClient_FetchMarketingPermissionIDsWithoutMembersReturnsError(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"members\":[]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.FetchMarketingPermissionIDs("list-id"); err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+}
+
+func TestClient_UpdateMemberRejectsIllegalStatusChange(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"email_address\":\"test@test.com\",\"status\":\"unsubscribed\"}"), nil
+		},
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	member := Member{EmailAddress: "test@test.com", Status: StatusSubscribed}
+	if err := client.UpdateMember("list-id", "test@test.com", member); err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+	if mock.PatchCalls != 0 {
+		t.Errorf("expected provider Patch() to not have been called, was called %d times", mock.PatchCalls)
+	}
+}
+
+func TestClient_UpdateMemberAllowsLegalStatusChange(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			expected := fmt.Sprintf("/lists/list-id/members/%s?fields=status", hashMd5("test@test.com"))
+			if s != expected {
+				t.Errorf("expected uri to be %s, but was %s", expected, s)
+			}
+			return []byte("{\"email_address\":\"test@test.com\",\"status\":\"unsubscribed\"}"), nil
+		},
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	member := Member{EmailAddress: "test@test.com", Status: StatusPending}
+	if err := client.UpdateMember("list-id", "test@test.com", member); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if mock.PatchCalls != 1 {
+		t.Errorf("expected provider Patch() to have been called once, was called %d times", mock.PatchCalls)
+	}
+}
+
+func TestClient_BatchWithUpdateRejectsCleanedStatus(t *testing.T) {
+	mock := MailChimpProviderMock{}
+	client := NewCustomDependencyClient(&mock)
+	members := []Member{{EmailAddress: "test@test.com", Status: StatusCleaned}}
+	if err := client.BatchWithUpdate("list-id", members); err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+	if mock.PostCalls != 0 {
+		t.Errorf("expected provider Post() to not have been called, was called %d times", mock.PostCalls)
+	}
+}
+
+func TestClient_FetchMembersByTagCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s == "/lists/list-id/tag-search?name=beta" {
+				return []byte("{\"tags\":[{\"id\":42,\"name\":\"beta\"}]}"), nil
+			}
+			if s != "/lists/list-id/segments/42/members?count=500" {
+				t.Errorf(
+					"expected uri to be /lists/list-id/segments/42/members?count=500, but was %s",
+					s,
+				)
+			}
+			return []byte("{\"members\":[{\"email_address\":\"a@test.com\",\"merge_fields\":{\"ADDRESS\":{\"addr1\":\"Main St 1\"}}}],\"total_items\":1}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	it := client.FetchMembersByTag("list-id", "beta")
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if it.Err() != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", it.Err().Error())
+	}
+	if count != 1 {
+		t.Errorf("expected 1 member but found %d", count)
+	}
+}
+
+func TestClient_FetchMembersByTagWithUnknownTag(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"tags\":[]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	it := client.FetchMembersByTag("list-id", "beta")
+	if it.Next() {
+		t.Error("expected Next to return false but returned true")
+	}
+	if it.Err() == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestClient_FetchListGrowthHistoryCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/lists/list-id/growth-history?count=1000" {
+				t.Errorf(
+					"expected uri to be /lists/list-id/growth-history?count=1000, but was %s",
+					s,
+				)
+			}
+			return []byte("{\"history\":[{\"month\":\"2021-03\",\"subscribed\":12}]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	history, err := client.FetchListGrowthHistory("list-id")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(history) != 1 || history[0].Subscribed != 12 {
+		t.Errorf("expected one month with 12 subscribed, but got %v", history)
+	}
+}
+
+func TestClient_FetchListActivityCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/lists/list-id/activity?count=180" {
+				t.Errorf("expected uri to be /lists/list-id/activity?count=180, but was %s", s)
+			}
+			return []byte("{\"activity\":[{\"day\":\"2021-03-01\",\"emails_sent\":100}]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	activity, err := client.FetchListActivity("list-id")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(activity) != 1 || activity[0].EmailsSent != 100 {
+		t.Errorf("expected one day with 100 emails sent, but got %v", activity)
+	}
+}
+
+func TestClient_FetchListLocationsCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/lists/list-id/locations" {
+				t.Errorf("expected uri to be /lists/list-id/locations, but was %s", s)
+			}
+			return []byte("{\"locations\":[{\"country\":\"Sweden\",\"cc\":\"SE\",\"percent\":75.5,\"total\":151}]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	locations, err := client.FetchListLocations("list-id")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(locations) != 1 || locations[0].CC != "SE" {
+		t.Errorf("expected one location in SE, but got %v", locations)
+	}
+}
+
+func TestClient_FetchListClientsCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/lists/list-id/clients" {
+				t.Errorf("expected uri to be /lists/list-id/clients, but was %s", s)
+			}
+			return []byte("{\"clients\":[{\"client\":\"Gmail\",\"members\":12}]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	clients, err := client.FetchListClients("list-id")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(clients) != 1 || clients[0].Members != 12 {
+		t.Errorf("expected one client with 12 members, but got %v", clients)
+	}
+}
+
+func TestClient_FetchAbuseReportsCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/lists/list-id/abuse-reports?count=20&offset=40" {
+				t.Errorf(
+					"expected uri to be /lists/list-id/abuse-reports?count=20&offset=40, but was %s",
+					s,
+				)
+			}
+			return []byte("{\"abuse_reports\":[{\"id\":1,\"email_id\":\"hash\"}],\"total_items\":41}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	reports, err := client.FetchAbuseReports("list-id", Page{Count: 20, Offset: 40})
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(reports.AbuseReports) != 1 || reports.TotalItems != 41 {
+		t.Errorf("expected one of 41 abuse reports, but got %+v", reports)
+	}
+}
+
+func TestClient_FetchAbuseReportsDecodesAddressMergeField(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte("{\"abuse_reports\":[{\"id\":1,\"merge_fields\":{\"AGE\":42,\"ADDRESS\":{\"addr1\":\"Main St 1\",\"city\":\"Stockholm\"}}}],\"total_items\":1}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	reports, err := client.FetchAbuseReports("list-id", Page{})
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(reports.AbuseReports) != 1 {
+		t.Fatalf("expected one abuse report, but got %d", len(reports.AbuseReports))
+	}
+	address, ok := reports.AbuseReports[0].MergeFields.Address("ADDRESS")
+	if !ok || address.City != "Stockholm" {
+		t.Errorf("expected ADDRESS to be decoded, but got %+v", address)
+	}
+}
+
+func TestClient_FetchAbuseReportMemberCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/lists/list-id/members/email-hash" {
+				t.Errorf("expected uri to be /lists/list-id/members/email-hash, but was %s", s)
+			}
+			return []byte("{\"email_address\":\"test@test.com\"}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	member, err := client.FetchAbuseReportMember(AbuseReport{ListID: "list-id", EmailID: "email-hash"})
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if member.EmailAddress != "test@test.com" {
+		t.Errorf("expected email address to be 'test@test.com', but was '%s'", member.EmailAddress)
+	}
+}
+
+func TestClient_FetchSignupFormsCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/lists/list-id/signup-forms" {
+				t.Errorf("expected uri to be /lists/list-id/signup-forms, but was %s", s)
+			}
+			return []byte("{\"signup_forms\":[{\"header\":{\"text\":\"Join us\"},\"list_id\":\"list-id\"}]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	forms, err := client.FetchSignupForms("list-id")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(forms) != 1 || forms[0].Header.Text != "Join us" {
+		t.Errorf("expected one form with header 'Join us', but got %v", forms)
+	}
+}
+
+func TestClient_CustomizeSignupFormCallsProviderWithCorrectParams(t *testing.T) {
+	form := SignupForm{
+		Header: SignupFormHeader{Text: "Join us"},
+		Contents: []SignupFormContent{
+			{Section: SignupFormSectionMessage, Value: "Get the newsletter"},
+		},
+		Styles: []SignupFormStyle{
+			{
+				Selector: "page_background",
+				Options:  []SignupFormStyleOption{{Property: "background-color", Value: "#ffffff"}},
+			},
+		},
+		SignupFormURL: "read-only",
+	}
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/lists/list-id/signup-forms" {
+				t.Errorf("expected uri to be /lists/list-id/signup-forms, but was %s", s)
+			}
+			payload := i.(signupFormPayload)
+			if payload.Header.Text != "Join us" || len(payload.Contents) != 1 || len(payload.Styles) != 1 {
+				t.Errorf("expected form to be sent, but got %+v", payload)
+			}
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.CustomizeSignupForm("list-id", form); err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+}
+
+func TestClient_CreateCampaignCallsProviderWithCorrectParams(t *testing.T) {
+	campaign := Campaign{
+		ID:         "read-only",
+		Type:       CampaignTypeRegular,
+		Recipients: CampaignRecipients{ListID: "list-id"},
+		Settings:   CampaignSettings{SubjectLine: "Hello"},
+	}
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/campaigns" {
+				t.Errorf("expected uri to be /campaigns, but was %s", s)
+			}
+			payload := i.(createCampaignPayload)
+			if payload.Type != CampaignTypeRegular || payload.Recipients.ListID != "list-id" {
+				t.Errorf("expected campaign to be sent, but got %+v", payload)
+			}
+			return []byte("{\"id\":\"campaign-id\"}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	created, err := client.CreateCampaign(campaign)
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if created.ID != "campaign-id" {
+		t.Errorf("expected campaign ID to be 'campaign-id', but was '%s'", created.ID)
+	}
+}
+
+func TestClient_CreateCampaignSendsOnlySetFlags(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			raw, _ := json.Marshal(i)
+			payload := struct {
+				Settings map[string]json.RawMessage `json:"settings"`
+			}{}
+			json.Unmarshal(raw, &payload)
+			if string(payload.Settings["inline_css"]) != "false" {
+				t.Errorf("expected inline_css to be false, but was '%s'", payload.Settings["inline_css"])
+			}
+			for _, key := range []string{"use_conversation", "authenticate", "auto_footer"} {
+				if _, ok := payload.Settings[key]; ok {
+					t.Errorf("expected '%s' to not be sent, but got %s", key, raw)
+				}
+			}
+			return []byte("{\"id\":\"campaign-id\"}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	campaign, err := validCampaignBuilder().InlineCSS(false).Build()
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if _, err := client.CreateCampaign(campaign); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_FetchCampaignsCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			expectedURI := "/campaigns?count=100&list_id=list-id&status=sent&type=regular"
+			if s != expectedURI {
+				t.Errorf("expected uri to be %s, but was %s", expectedURI, s)
+			}
+			return []byte("{\"campaigns\":[{\"id\":\"a\"},{\"id\":\"b\"}]}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	campaigns, err := client.FetchCampaigns(CampaignFilters{
+		Type:   CampaignTypeRegular,
+		Status: CampaignStatusSent,
+		ListID: "list-id",
+		Page:   Page{Count: 100},
+	})
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(campaigns) != 2 {
+		t.Errorf("expected 2 campaigns to be returned, but got %d", len(campaigns))
+	}
+}
+
+func TestClient_FetchCampaignCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/campaigns/campaign-id" {
+				t.Errorf("expected uri to be /campaigns/campaign-id, but was %s", s)
+			}
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.FetchCampaign("campaign-id"); err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+}
+
+func TestClient_UpdateCampaignCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/campaigns/campaign-id" {
+				t.Errorf("expected uri to be /campaigns/campaign-id, but was %s", s)
+			}
+			payload := i.(updateCampaignPayload)
+			if payload.Settings.SubjectLine != "Updated" {
+				t.Errorf("expected subject line to be 'Updated', but was '%s'", payload.Settings.SubjectLine)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	client.UpdateCampaign("campaign-id", Campaign{Settings: CampaignSettings{SubjectLine: "Updated"}})
+	if mock.PatchCalls != 1 {
+		t.Errorf("expected provider Patch() to have been called once, was called %d times", mock.PatchCalls)
+	}
+}
+
+func TestClient_FetchCampaignsFiltersOnFolder(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/campaigns?folder_id=folder-id" {
+				t.Errorf("expected uri to be /campaigns?folder_id=folder-id, but was %s", s)
+			}
+			return []byte(`{"campaigns":[]}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.FetchCampaigns(CampaignFilters{FolderID: "folder-id"}); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_UpdateCampaignMovesFetchedCampaignToFolder(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte(`{
+				"id": "campaign-id",
+				"recipients": {"list_id": "list-id", "list_name": "Newsletter"},
+				"settings": {"subject_line": "Hello", "from_name": "Test", "reply_to": "reply@test.com", "auto_footer": true, "folder_id": "old-folder"}
+			}`), nil
+		},
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			raw, _ := json.Marshal(i)
+			payload := map[string]map[string]interface{}{}
+			json.Unmarshal(raw, &payload)
+			if payload["settings"]["folder_id"] != "new-folder" {
+				t.Errorf("expected folder_id to be 'new-folder', but got %s", raw)
+			}
+			if payload["settings"]["from_name"] != "Test" || payload["settings"]["auto_footer"] != true {
+				t.Errorf("expected the fetched settings to be kept, but got %s", raw)
+			}
+			if payload["recipients"]["list_id"] != "list-id" {
+				t.Errorf("expected the fetched recipients to be kept, but got %s", raw)
+			}
+			return []byte(`{"id":"campaign-id","settings":{"folder_id":"new-folder"}}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	campaign, err := client.FetchCampaign("campaign-id")
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	campaign.Settings.FolderID = "new-folder"
+	updated, err := client.UpdateCampaign("campaign-id", campaign)
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if updated.Settings.FolderID != "new-folder" {
+		t.Errorf("expected folder ID to be decoded, but was '%s'", updated.Settings.FolderID)
+	}
+}
+
+func TestClient_UpdateCampaignCanMoveCampaignOutOfFolder(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			raw, _ := json.Marshal(i)
+			payload := map[string]map[string]interface{}{}
+			json.Unmarshal(raw, &payload)
+			if folderID, ok := payload["settings"]["folder_id"]; !ok || folderID != "" {
+				t.Errorf("expected an empty folder_id to be sent, but got %s", raw)
+			}
+			if _, ok := payload["recipients"]; ok {
+				t.Errorf("expected recipients without a list to not be sent, but got %s", raw)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	client.UpdateCampaign("campaign-id", Campaign{
+		Settings: CampaignSettings{
+			SubjectLine: "Hello",
+			FromName:    "Test",
+			ReplyTo:     "reply@test.com",
+		},
+	})
+	if mock.PatchCalls != 1 {
+		t.Errorf("expected provider Patch() to have been called once, was called %d times", mock.PatchCalls)
+	}
+}
+
+func TestClient_DeleteCampaignCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		DeleteMock: func(s string) ([]byte, error) {
+			if s != "/campaigns/campaign-id" {
+				t.Errorf("expected uri to be /campaigns/campaign-id, but was %s", s)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.DeleteCampaign("campaign-id"); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_SetCampaignContentCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PutMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/campaigns/campaign-id/content" {
+				t.Errorf("expected uri to be /campaigns/campaign-id/content, but was %s", s)
+			}
+			payload := i.(CampaignContent)
+			if payload.Template == nil || payload.Template.ID != 12 {
+				t.Errorf("expected template 12 to be sent, but got %+v", payload)
+			}
+			return []byte("{\"html\":\"<p>Hello</p>\",\"plain_text\":\"Hello\"}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	rendered, err := client.SetCampaignContent(
+		"campaign-id",
+		NewTemplateCampaignContent(12, map[string]string{"body": "<p>Hello</p>"}),
+	)
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if rendered.PlainText != "Hello" {
+		t.Errorf("expected plain text to be 'Hello', but was '%s'", rendered.PlainText)
+	}
+}
+
+func TestClient_SetCampaignContentWithInvalidContentDoesNotPut(t *testing.T) {
+	mock := MailChimpProviderMock{}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.SetCampaignContent("campaign-id", CampaignContent{}); err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+	if mock.PutCalls != 0 {
+		t.Errorf("expected provider Put() to not have been called, was called %d times", mock.PutCalls)
+	}
+}
+
+func TestClient_FetchCampaignContentCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/campaigns/campaign-id/content" {
+				t.Errorf("expected uri to be /campaigns/campaign-id/content, but was %s", s)
+			}
+			return []byte("{\"html\":\"<p>Hello</p>\"}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	rendered, err := client.FetchCampaignContent("campaign-id")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if rendered.HTML != "<p>Hello</p>" {
+		t.Errorf("expected html to be '<p>Hello</p>', but was '%s'", rendered.HTML)
+	}
+}
+
+func TestClient_SendCampaignReturnsCampaignNotReadyError(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/campaigns/campaign-id/actions/send" {
+				t.Errorf("expected uri to be /campaigns/campaign-id/actions/send, but was %s", s)
+			}
+			return nil, &APIError{
+				Status: 400,
+				Detail: "Your Campaign is not ready to send.",
+				Errors: []APIFieldError{{Message: "No content"}},
+			}
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	err := client.SendCampaign("campaign-id")
+	var notReady *CampaignNotReadyError
+	if !errors.As(err, &notReady) {
+		t.Fatalf("expected error to be a CampaignNotReadyError, but was %v", err)
+	}
+	if notReady.CampaignID != "campaign-id" || len(notReady.Errors) != 1 {
+		t.Errorf("expected campaign ID and one error, but got %+v", notReady)
+	}
+}
+
+func TestClient_SendCampaignPassesOtherErrorsThrough(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			return nil, &APIError{Status: 404, Detail: "not found"}
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	err := client.SendCampaign("campaign-id")
+	var notReady *CampaignNotReadyError
+	if err == nil || errors.As(err, &notReady) {
+		t.Errorf("expected a regular error to be returned, but got %v", err)
+	}
+}
+
+func TestClient_FetchSendChecklistCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/campaigns/campaign-id/send-checklist" {
+				t.Errorf("expected uri to be /campaigns/campaign-id/send-checklist, but was %s", s)
+			}
+			return []byte(`{"is_ready":true,"items":[{"type":"warning","id":1,"heading":"No plain text","details":"Add one"}]}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	checklist, err := client.FetchSendChecklist("campaign-id")
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if !checklist.IsReady() || len(checklist.Items) != 1 || checklist.Items[0].Type != ChecklistItemTypeWarning {
+		t.Errorf("expected a ready checklist with one warning, but got %+v", checklist)
+	}
+}
+
+func TestClient_SendCampaignWithChecklistReturnsFailingItems(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte(`{"is_ready":false,"items":[
+				{"type":"success","id":1,"heading":"Subject line"},
+				{"type":"error","id":2,"heading":"No content"}
+			]}`), nil
+		},
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	err := client.SendCampaignWithChecklist("campaign-id")
+	var notReady *CampaignNotReadyError
+	if !errors.As(err, &notReady) {
+		t.Fatalf("expected error to be a CampaignNotReadyError, but was %v", err)
+	}
+	if len(notReady.Items) != 1 || notReady.Items[0].Heading != "No content" {
+		t.Errorf("expected the failing item to be returned, but got %+v", notReady.Items)
+	}
+	if mock.PostCalls != 0 {
+		t.Errorf("expected campaign to not be sent, but provider.Post was called %d times", mock.PostCalls)
+	}
+}
+
+func TestClient_SendCampaignWithChecklistSendsReadyCampaign(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte(`{"is_ready":true,"items":[]}`), nil
+		},
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/campaigns/campaign-id/actions/send" {
+				t.Errorf("expected uri to be /campaigns/campaign-id/actions/send, but was %s", s)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.SendCampaignWithChecklist("campaign-id"); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if mock.PostCalls != 1 {
+		t.Errorf("expected provider.Post to be called once, but was called %d times", mock.PostCalls)
+	}
+}
+
+func TestClient_SendTestEmailCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/campaigns/campaign-id/actions/test" {
+				t.Errorf("expected uri to be /campaigns/campaign-id/actions/test, but was %s", s)
+			}
+			payload := i.(sendTestEmailPayload)
+			if len(payload.TestEmails) != 1 || payload.SendType != TestEmailTypeHTML {
+				t.Errorf("expected one html test email, but got %+v", payload)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.SendTestEmail("campaign-id", []string{"test@test.com"}, TestEmailTypeHTML); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if err := client.SendTestEmail("campaign-id", []string{"test@test.com"}, "pdf"); err == nil {
+		t.Error("expected error to be returned for invalid type but none was")
+	}
+	if mock.PostCalls != 1 {
+		t.Errorf("expected provider Post() to have been called once, was called %d times", mock.PostCalls)
+	}
+}
+
+func TestClient_ScheduleCampaignCallsProviderWithCorrectParams(t *testing.T) {
+	scheduleTime := time.Date(2021, 3, 1, 13, 30, 0, 0, time.FixedZone("CET", 3600))
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/campaigns/campaign-id/actions/schedule" {
+				t.Errorf("expected uri to be /campaigns/campaign-id/actions/schedule, but was %s", s)
+			}
+			payload := i.(scheduleCampaignPayload)
+			if payload.ScheduleTime != "2021-03-01T12:30:00Z" {
+				t.Errorf("expected schedule time to be '2021-03-01T12:30:00Z', but was '%s'", payload.ScheduleTime)
+			}
+			if !payload.Timewarp || payload.BatchDelivery == nil || payload.BatchDelivery.BatchCount != 2 {
+				t.Errorf("expected timewarp and batch delivery to be sent, but got %+v", payload)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	err := client.ScheduleCampaign("campaign-id", scheduleTime, true, &BatchDelivery{BatchDelay: 5, BatchCount: 2})
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_ScheduleCampaignRejectsTimesOffQuarterHour(t *testing.T) {
+	mock := MailChimpProviderMock{}
+	client := NewCustomDependencyClient(&mock)
+	scheduleTime := time.Date(2021, 3, 1, 13, 31, 0, 0, time.UTC)
+	if err := client.ScheduleCampaign("campaign-id", scheduleTime, false, nil); err == nil {
+		t.Error("expected error to be returned but none was")
+	}
+	if mock.PostCalls != 0 {
+		t.Errorf("expected provider Post() to not have been called, was called %d times", mock.PostCalls)
+	}
+}
+
+func TestClient_CampaignActionsCallProviderWithCorrectParams(t *testing.T) {
+	var uri string
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			uri = s
+			return []byte("{\"id\":\"replica-id\"}"), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	actions := map[string]func(string) error{
+		"unschedule":  client.UnscheduleCampaign,
+		"pause":       client.PauseCampaign,
+		"resume":      client.ResumeCampaign,
+		"cancel-send": client.CancelSend,
+	}
+	for action, fn := range actions {
+		fn("campaign-id")
+		if uri != fmt.Sprintf("/campaigns/campaign-id/actions/%s", action) {
+			t.Errorf("expected uri to be /campaigns/campaign-id/actions/%s, but was %s", action, uri)
+		}
+	}
+	replica, err := client.ReplicateCampaign("campaign-id")
+	if err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if uri != "/campaigns/campaign-id/actions/replicate" || replica.ID != "replica-id" {
+		t.Errorf("expected campaign to be replicated, but got uri %s and %+v", uri, replica)
+	}
+}
+
+func TestClient_FetchCampaignReportCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/reports/campaign-id" {
+				t.Errorf("expected uri to be /reports/campaign-id, but was %s", s)
+			}
+			return []byte(`{
+				"id": "campaign-id",
+				"emails_sent": 200,
+				"bounces": {"hard_bounces": 2, "soft_bounces": 1},
+				"opens": {"opens_total": 120, "unique_opens": 80, "open_rate": 0.4},
+				"clicks": {"clicks_total": 30, "click_rate": 0.1},
+				"industry_stats": {"type": "Software", "open_rate": 0.2}
+			}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	report, err := client.FetchCampaignReport("campaign-id")
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if report.EmailsSent != 200 || report.Bounces.HardBounces != 2 || report.Opens.UniqueOpens != 80 {
+		t.Errorf("expected report to be decoded, but got %+v", report)
+	}
+	if report.IndustryStats.Type != "Software" || report.Clicks.ClickRate != 0.1 {
+		t.Errorf("expected nested stats to be decoded, but got %+v", report)
+	}
+}
+
+func TestClient_FetchCampaignReportReturnsErrorIfProviderFails(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.FetchCampaignReport("campaign-id"); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestClient_FetchSubReportsCallProviderWithCorrectParams(t *testing.T) {
+	var uri string
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			uri = s
+			return []byte(`{}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	page := Page{Count: 50, Offset: 100}
+	tests := []struct {
+		expected string
+		fetch    func() error
+	}{
+		{"/reports/c1/click-details?count=50&offset=100", func() error {
+			_, err := client.FetchReportClickDetails("c1", page)
+			return err
+		}},
+		{"/reports/c1/open-details?count=50&offset=100", func() error {
+			_, err := client.FetchReportOpenDetails("c1", page)
+			return err
+		}},
+		{"/reports/c1/email-activity?count=50&offset=100", func() error {
+			_, err := client.FetchReportEmailActivity("c1", page)
+			return err
+		}},
+		{"/reports/c1/unsubscribed?count=50&offset=100", func() error {
+			_, err := client.FetchReportUnsubscribes("c1", page)
+			return err
+		}},
+		{"/reports/c1/sent-to?count=50&offset=100", func() error {
+			_, err := client.FetchReportSentTo("c1", page)
+			return err
+		}},
+		{"/reports/c1/domain-performance", func() error {
+			_, err := client.FetchReportDomainPerformance("c1")
+			return err
+		}},
+		{"/reports/c1/locations?count=50&offset=100", func() error {
+			_, err := client.FetchReportLocations("c1", page)
+			return err
+		}},
+	}
+	for _, test := range tests {
+		if err := test.fetch(); err != nil {
+			t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+		}
+		if uri != test.expected {
+			t.Errorf("expected uri to be %s, but was %s", test.expected, uri)
+		}
+	}
+}
+
+func TestClient_FetchReportEmailActivityDecodesActivity(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte(`{
+				"emails": [{
+					"email_address": "test@test.com",
+					"activity": [
+						{"action": "open", "timestamp": "2021-03-01T12:00:00+00:00"},
+						{"action": "click", "timestamp": "2021-03-01T12:05:00+00:00", "url": "https://example.com"}
+					]
+				}],
+				"total_items": 1
+			}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	collection, err := client.FetchReportEmailActivity("c1", Page{})
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if collection.TotalItems != 1 || len(collection.Emails) != 1 {
+		t.Fatalf("expected one email, but got %+v", collection)
+	}
+	activity := collection.Emails[0].Activity
+	if len(activity) != 2 || activity[1].URL != "https://example.com" || activity[0].Timestamp.IsZero() {
+		t.Errorf("expected activity to be decoded, but got %+v", activity)
+	}
+}
+
+func TestClient_FetchReportMembersDecodeAddressMergeField(t *testing.T) {
+	member := `{
+		"email_address": "test@test.com",
+		"merge_fields": {
+			"FNAME": "Test",
+			"AGE": 42,
+			"ADDRESS": {"addr1": "Main St 1", "city": "Stockholm", "zip": "11122", "country": "SE"}
+		}
+	}`
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			switch {
+			case strings.Contains(s, "/open-details"):
+				return []byte(`{"members": [` + member + `]}`), nil
+			case strings.Contains(s, "/unsubscribed"):
+				return []byte(`{"unsubscribes": [` + member + `]}`), nil
+			default:
+				return []byte(`{"sent_to": [` + member + `]}`), nil
+			}
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	opens, err := client.FetchReportOpenDetails("c1", Page{})
+	if err != nil || len(opens.Members) != 1 {
+		t.Fatalf("expected one open detail, but got %+v and error %v", opens, err)
+	}
+	unsubscribes, err := client.FetchReportUnsubscribes("c1", Page{})
+	if err != nil || len(unsubscribes.Unsubscribes) != 1 {
+		t.Fatalf("expected one unsubscribe, but got %+v and error %v", unsubscribes, err)
+	}
+	sentTo, err := client.FetchReportSentTo("c1", Page{})
+	if err != nil || len(sentTo.SentTo) != 1 {
+		t.Fatalf("expected one sent to, but got %+v and error %v", sentTo, err)
+	}
+	for _, mergeFields := range []MergeFields{
+		opens.Members[0].MergeFields,
+		unsubscribes.Unsubscribes[0].MergeFields,
+		sentTo.SentTo[0].MergeFields,
+	} {
+		if mergeFields.String("FNAME") != "Test" || mergeFields.String("AGE") != "42" {
+			t.Errorf("expected text and number fields to be decoded, but got %v", mergeFields)
+		}
+		if address, ok := mergeFields.Address("ADDRESS"); !ok || address.City != "Stockholm" {
+			t.Errorf("expected ADDRESS to be decoded, but got %+v", address)
+		}
+	}
+}
+
+func TestClient_FetchReportDomainPerformanceReturnsErrorIfProviderFails(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.FetchReportDomainPerformance("c1"); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestClient_StreamEmailActivityCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			expected := "/reports/c1/email-activity?count=1000&since=2021-03-01T12%3A00%3A00Z"
+			if s != expected {
+				t.Errorf("expected uri to be %s, but was %s", expected, s)
+			}
+			return []byte(`{"emails":[{"email_address":"a@test.com"}],"total_items":1}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	since := time.Date(2021, 3, 1, 13, 0, 0, 0, time.FixedZone("CET", 3600))
+	it := client.StreamEmailActivity("c1", since)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if it.Err() != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", it.Err().Error())
+	}
+	if count != 1 || mock.GetCalls != 1 {
+		t.Errorf("expected one member from one request, but got %d from %d", count, mock.GetCalls)
+	}
+}
+
+func TestClient_StreamEmailActivityReturnsErrorIfProviderFails(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	it := client.StreamEmailActivity("c1", time.Time{})
+	if it.Next() || it.Err() == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestClient_CreateTemplateCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/templates" {
+				t.Errorf("expected uri to be /templates, but was %s", s)
+			}
+			payload := i.(templatePayload)
+			if payload.Name != "Newsletter" || payload.HTML != "<p>Hello</p>" {
+				t.Errorf("expected name and html to be sent, but got %+v", payload)
+			}
+			return []byte(`{"id":42,"name":"Newsletter","type":"user"}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	template, err := client.CreateTemplate(Template{Name: "Newsletter", HTML: "<p>Hello</p>"})
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if template.ID != 42 {
+		t.Errorf("expected template ID to be 42, but was %d", template.ID)
+	}
+}
+
+func TestClient_FetchTemplatesCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			expected := "/templates?count=20&folder_id=f1&type=user"
+			if s != expected {
+				t.Errorf("expected uri to be %s, but was %s", expected, s)
+			}
+			return []byte(`{"templates":[{"id":1},{"id":2}],"total_items":2}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	templates, err := client.FetchTemplates(TemplateFilters{
+		Type:     TemplateTypeUser,
+		FolderID: "f1",
+		Page:     Page{Count: 20},
+	})
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(templates) != 2 {
+		t.Errorf("expected 2 templates but found %d", len(templates))
+	}
+}
+
+func TestClient_UpdateTemplateCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/templates/42" {
+				t.Errorf("expected uri to be /templates/42, but was %s", s)
+			}
+			raw, _ := json.Marshal(i)
+			payload := map[string]interface{}{}
+			json.Unmarshal(raw, &payload)
+			if payload["name"] != "Newsletter" {
+				t.Errorf("expected name to be 'Newsletter', but got %s", raw)
+			}
+			for _, key := range []string{"html", "folder_id"} {
+				if _, ok := payload[key]; ok {
+					t.Errorf("expected field '%s' to not be sent, but got %s", key, raw)
+				}
+			}
+			return []byte(`{"id":42}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.UpdateTemplate(42, Template{Name: "Newsletter"}); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_DeleteTemplateReturnsErrorIfProviderFails(t *testing.T) {
+	mock := MailChimpProviderMock{
+		DeleteMock: func(s string) ([]byte, error) {
+			if s != "/templates/42" {
+				t.Errorf("expected uri to be /templates/42, but was %s", s)
+			}
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.DeleteTemplate(42); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestClient_FetchTemplateDefaultContentCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/templates/42/default-content" {
+				t.Errorf("expected uri to be /templates/42/default-content, but was %s", s)
+			}
+			return []byte(`{"sections":{"body":"<p>Default</p>"}}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	content, err := client.FetchTemplateDefaultContent(42)
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if content.Sections["body"] != "<p>Default</p>" {
+		t.Errorf("expected body section to be decoded, but got %v", content.Sections)
+	}
+}
+
+func TestClient_SyncTemplateCreatesMissingTemplate(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/templates?count=1000&type=user" {
+				t.Errorf("expected uri to be /templates?count=1000&type=user, but was %s", s)
+			}
+			return []byte(`{"templates":[{"id":1,"name":"Other"}],"total_items":1}`), nil
+		},
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			return []byte(`{"id":2,"name":"Newsletter"}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	template, err := client.SyncTemplate(Template{Name: "Newsletter", HTML: "<p>Hello</p>"})
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if mock.PostCalls != 1 || mock.PatchCalls != 0 || template.ID != 2 {
+		t.Errorf("expected template to be created, but got %+v", template)
+	}
+}
+
+func TestClient_SyncTemplateUpdatesExistingTemplate(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if strings.Contains(s, "offset=1") {
+				return []byte(`{"templates":[{"id":7,"name":"Newsletter"}],"total_items":2}`), nil
+			}
+			return []byte(`{"templates":[{"id":1,"name":"Other"}],"total_items":2}`), nil
+		},
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/templates/7" {
+				t.Errorf("expected uri to be /templates/7, but was %s", s)
+			}
+			return []byte(`{"id":7,"name":"Newsletter"}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.SyncTemplate(Template{Name: "Newsletter", HTML: "<p>Hello</p>"}); err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if mock.GetCalls != 2 || mock.PatchCalls != 1 || mock.PostCalls != 0 {
+		t.Errorf(
+			"expected two pages to be fetched and the template updated, but got %d gets, %d patches and %d posts",
+			mock.GetCalls,
+			mock.PatchCalls,
+			mock.PostCalls,
+		)
+	}
+}
+
+func TestClient_SyncTemplateReturnsErrorForDuplicateNames(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte(`{"templates":[{"id":1,"name":"Newsletter"},{"id":2,"name":"Newsletter"}],"total_items":2}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.SyncTemplate(Template{Name: "Newsletter", HTML: "<p>Hello</p>"}); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+	if mock.PostCalls != 0 || mock.PatchCalls != 0 {
+		t.Error("expected no template to be created or updated")
+	}
+}
+
+func TestClient_SyncTemplateFromFileSendsFileContents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "newsletter.html")
+	if err := ioutil.WriteFile(path, []byte("<p>From file</p>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			return []byte(`{"templates":[],"total_items":0}`), nil
+		},
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			payload := i.(templatePayload)
+			if payload.Name != "Newsletter" || payload.HTML != "<p>From file</p>" {
+				t.Errorf("expected file contents to be sent, but got %+v", payload)
+			}
+			return []byte(`{"id":3}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.SyncTemplateFromFile("Newsletter", path); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_SyncTemplateFromFileReturnsErrorForMissingFile(t *testing.T) {
+	mock := MailChimpProviderMock{}
+	client := NewCustomDependencyClient(&mock)
+	path := filepath.Join(t.TempDir(), "missing.html")
+	if _, err := client.SyncTemplateFromFile("Newsletter", path); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestClient_TemplateFoldersCallProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/template-folders" {
+				t.Errorf("expected uri to be /template-folders, but was %s", s)
+			}
+			if i.(templateFolderPayload).Name != "Newsletters" {
+				t.Errorf("expected name to be sent, but got %+v", i)
+			}
+			return []byte(`{"id":"f1","name":"Newsletters"}`), nil
+		},
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/template-folders?count=10" {
+				t.Errorf("expected uri to be /template-folders?count=10, but was %s", s)
+			}
+			return []byte(`{"folders":[{"id":"f1","name":"Newsletters","count":3}],"total_items":1}`), nil
+		},
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/template-folders/f1" {
+				t.Errorf("expected uri to be /template-folders/f1, but was %s", s)
+			}
+			return []byte(`{"id":"f1","name":"Archive"}`), nil
+		},
+		DeleteMock: func(s string) ([]byte, error) {
+			if s != "/template-folders/f1" {
+				t.Errorf("expected uri to be /template-folders/f1, but was %s", s)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if folder, err := client.CreateTemplateFolder("Newsletters"); err != nil || folder.ID != "f1" {
+		t.Errorf("expected folder f1 to be created, but got %+v and %v", folder, err)
+	}
+	if folders, err := client.FetchTemplateFolders(Page{Count: 10}); err != nil || len(folders) != 1 || folders[0].Count != 3 {
+		t.Errorf("expected one folder to be fetched, but got %+v and %v", folders, err)
+	}
+	if folder, err := client.UpdateTemplateFolder("f1", "Archive"); err != nil || folder.Name != "Archive" {
+		t.Errorf("expected folder to be renamed, but got %+v and %v", folder, err)
+	}
+	if err := client.DeleteTemplateFolder("f1"); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_CampaignFoldersCallProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/campaign-folders" {
+				t.Errorf("expected uri to be /campaign-folders, but was %s", s)
+			}
+			if i.(campaignFolderPayload).Name != "Newsletters" {
+				t.Errorf("expected name to be sent, but got %+v", i)
+			}
+			return []byte(`{"id":"f1","name":"Newsletters"}`), nil
+		},
+		GetMock: func(s string) ([]byte, error) {
+			if s == "/campaign-folders/f1" {
+				return []byte(`{"id":"f1","name":"Newsletters","count":12}`), nil
+			}
+			if s != "/campaign-folders?count=10&offset=10" {
+				t.Errorf("expected uri to be /campaign-folders?count=10&offset=10, but was %s", s)
+			}
+			return []byte(`{"folders":[{"id":"f1"},{"id":"f2"}],"total_items":12}`), nil
+		},
+		PatchMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/campaign-folders/f1" {
+				t.Errorf("expected uri to be /campaign-folders/f1, but was %s", s)
+			}
+			return []byte(`{"id":"f1","name":"Archive"}`), nil
+		},
+		DeleteMock: func(s string) ([]byte, error) {
+			if s != "/campaign-folders/f1" {
+				t.Errorf("expected uri to be /campaign-folders/f1, but was %s", s)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if folder, err := client.CreateCampaignFolder("Newsletters"); err != nil || folder.ID != "f1" {
+		t.Errorf("expected folder f1 to be created, but got %+v and %v", folder, err)
+	}
+	if folders, err := client.FetchCampaignFolders(Page{Count: 10, Offset: 10}); err != nil || len(folders) != 2 {
+		t.Errorf("expected two folders to be fetched, but got %+v and %v", folders, err)
+	}
+	if folder, err := client.FetchCampaignFolder("f1"); err != nil || folder.Count != 12 {
+		t.Errorf("expected folder f1 to be fetched, but got %+v and %v", folder, err)
+	}
+	if folder, err := client.UpdateCampaignFolder("f1", "Archive"); err != nil || folder.Name != "Archive" {
+		t.Errorf("expected folder to be renamed, but got %+v and %v", folder, err)
+	}
+	if err := client.DeleteCampaignFolder("f1"); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_DeleteCampaignFolderReturnsErrorIfProviderFails(t *testing.T) {
+	mock := MailChimpProviderMock{
+		DeleteMock: func(s string) ([]byte, error) {
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.DeleteCampaignFolder("f1"); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestClient_FetchAutomationsCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/automations?count=50" {
+				t.Errorf("expected uri to be /automations?count=50, but was %s", s)
+			}
+			return []byte(`{"automations":[{"id":"w1","status":"sending","recipients":{"list_id":"list-id"}}],"total_items":1}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	automations, err := client.FetchAutomations(Page{Count: 50})
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(automations) != 1 || automations[0].Status != AutomationStatusSending || automations[0].Recipients.ListID != "list-id" {
+		t.Errorf("expected one sending automation, but got %+v", automations)
+	}
+}
+
+func TestClient_FetchAutomationReturnsErrorIfProviderFails(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/automations/w1" {
+				t.Errorf("expected uri to be /automations/w1, but was %s", s)
+			}
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if _, err := client.FetchAutomation("w1"); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestClient_StartAndPauseAutomationCallProviderWithCorrectParams(t *testing.T) {
+	uris := make([]string, 0)
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			uris = append(uris, s)
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	client.StartAutomation("w1")
+	client.PauseAutomation("w1")
+	expected := []string{
+		"/automations/w1/actions/start-all-emails",
+		"/automations/w1/actions/pause-all-emails",
+	}
+	if len(uris) != 2 || uris[0] != expected[0] || uris[1] != expected[1] {
+		t.Errorf("expected uris to be %v, but were %v", expected, uris)
+	}
+}
+
+func TestClient_FetchAutomationEmailsCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		GetMock: func(s string) ([]byte, error) {
+			if s != "/automations/w1/emails" {
+				t.Errorf("expected uri to be /automations/w1/emails, but was %s", s)
+			}
+			return []byte(`{"emails":[{"id":"e1","workflow_id":"w1","position":1,"delay":{"amount":2,"type":"day"}}]}`), nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	emails, err := client.FetchAutomationEmails("w1")
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if len(emails) != 1 || emails[0].Delay.Amount != 2 || emails[0].WorkflowID != "w1" {
+		t.Errorf("expected one email to be decoded, but got %+v", emails)
+	}
+}
+
+func TestClient_AddSubscriberToAutomationQueueCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/automations/w1/emails/e1/queue" {
+				t.Errorf("expected uri to be /automations/w1/emails/e1/queue, but was %s", s)
+			}
+			payload := i.(automationSubscriberPayload)
+			if payload.EmailAddress != "test@test.com" {
+				t.Errorf("expected email address to be 'test@test.com', but was '%s'", payload.EmailAddress)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.AddSubscriberToAutomationQueue("w1", "e1", "Test@Test.com"); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_RemoveSubscriberFromAutomationCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			if s != "/automations/w1/removed-subscribers" {
+				t.Errorf("expected uri to be /automations/w1/removed-subscribers, but was %s", s)
+			}
+			payload := i.(automationSubscriberPayload)
+			if payload.EmailAddress != "test@test.com" {
+				t.Errorf("expected email address to be 'test@test.com', but was '%s'", payload.EmailAddress)
+			}
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.RemoveSubscriberFromAutomation("w1", "Test@Test.com"); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestClient_TriggerJourneyStepCallsProviderWithCorrectParams(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			expected := "/customer-journeys/journeys/12/steps/34/actions/trigger"
+			if s != expected {
+				t.Errorf("expected uri to be %s, but was %s", expected, s)
+			}
+			payload := i.(journeyTriggerPayload)
+			if payload.EmailAddress != "test@test.com" {
+				t.Errorf("expected email address to be 'test@test.com', but was '%s'", payload.EmailAddress)
+			}
+			return nil, nil
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.TriggerJourneyStep(12, 34, "Test@Test.com"); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+}
+
+func TestClient_TriggerJourneyStepReturnsErrorIfProviderFails(t *testing.T) {
+	mock := MailChimpProviderMock{
+		PostMock: func(s string, i interface{}) ([]byte, error) {
+			return nil, errors.New("mocked error")
+		},
+	}
+	client := NewCustomDependencyClient(&mock)
+	if err := client.TriggerJourneyStep(12, 34, "test@test.com"); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
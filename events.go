@@ -0,0 +1,114 @@
+package mailchimp
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var NullEvent = Event{}
+
+// eventNamePattern matches the event names accepted by MailChimp, which
+// are 2 to 30 characters long and made up of letters, numbers,
+// underscores and dashes.
+var eventNamePattern = regexp.MustCompile("^[A-Za-z0-9_-]{2,30}$")
+
+type Event struct {
+	Name       string            `json:"name" mc_validator:"required"`
+	Properties map[string]string `json:"properties,omitempty"`
+	IsSyncing  bool              `json:"is_syncing,omitempty"`
+	OccurredAt *time.Time        `json:"occurred_at,omitempty"`
+}
+
+type eventCollection struct {
+	Events     []Event `json:"events"`
+	TotalItems int     `json:"total_items"`
+}
+
+type EventBuilder struct {
+	obj        Event
+	properties map[string]interface{}
+}
+
+func (eb EventBuilder) Build() (Event, error) {
+	if invalidParams, valid := validate(eb.obj); !valid {
+		return NullEvent, fmt.Errorf(
+			"could not build event due to invalid parameters %v",
+			invalidParams,
+		)
+	}
+	if !eventNamePattern.MatchString(eb.obj.Name) {
+		return NullEvent, fmt.Errorf(
+			"could not build event due to invalid name '%s', must be 2-30 letters, numbers, underscores or dashes",
+			eb.obj.Name,
+		)
+	}
+	event := eb.obj
+	if len(eb.properties) == 0 {
+		return event, nil
+	}
+	event.Properties = make(map[string]string)
+	invalidProperties := make([]string, 0)
+	for name, value := range eb.properties {
+		property, ok := eventPropertyValue(value)
+		if !ok || name == "" {
+			invalidProperties = append(invalidProperties, name)
+			continue
+		}
+		event.Properties[name] = property
+	}
+	if len(invalidProperties) > 0 {
+		sort.Strings(invalidProperties)
+		return NullEvent, fmt.Errorf(
+			"could not build event due to invalid properties %v",
+			invalidProperties,
+		)
+	}
+	return event, nil
+}
+
+func (eb EventBuilder) Name(name string) EventBuilder {
+	eb.obj.Name = name
+	return eb
+}
+
+// Property adds a property to the event. MailChimp only stores string
+// properties, so strings, booleans and numbers are accepted and
+// converted, while any other type makes Build return an error.
+func (eb EventBuilder) Property(name string, value interface{}) EventBuilder {
+	if eb.properties == nil {
+		eb.properties = make(map[string]interface{})
+	}
+	eb.properties[name] = value
+	return eb
+}
+
+// Syncing marks the event as part of a data import, which means that
+// it will not trigger any automations.
+func (eb EventBuilder) Syncing() EventBuilder {
+	eb.obj.IsSyncing = true
+	return eb
+}
+
+func (eb EventBuilder) OccurredAt(occurredAt time.Time) EventBuilder {
+	eb.obj.OccurredAt = &occurredAt
+	return eb
+}
+
+func eventPropertyValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	}
+	return "", false
+}
@@ -0,0 +1,63 @@
+package mailchimp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEventBuilder_BuildShouldPass(t *testing.T) {
+	occurredAt := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	event, err := EventBuilder{}.
+		Name("trial_started").
+		Property("plan", "pro").
+		Property("seats", 5).
+		Property("annual", true).
+		OccurredAt(occurredAt).
+		Build()
+	if err != nil {
+		t.Errorf(
+			"expected no error to be returned, but got '%s'",
+			err.Error(),
+		)
+	}
+	expected := map[string]string{"plan": "pro", "seats": "5", "annual": "true"}
+	for name, value := range expected {
+		if event.Properties[name] != value {
+			t.Errorf(
+				"expected property '%s' to be '%s' but was '%s'",
+				name,
+				value,
+				event.Properties[name],
+			)
+		}
+	}
+	if event.OccurredAt == nil || !event.OccurredAt.Equal(occurredAt) {
+		t.Errorf("expected occurred at to be %v but was %v", occurredAt, event.OccurredAt)
+	}
+}
+
+func TestEventBuilder_BuildWithoutNameReturnsError(t *testing.T) {
+	_, err := EventBuilder{}.Property("plan", "pro").Build()
+	if err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestEventBuilder_BuildWithInvalidNameReturnsError(t *testing.T) {
+	for _, name := range []string{"a", "trial started", "this-event-name-is-far-too-long"} {
+		_, err := EventBuilder{}.Name(name).Build()
+		if err == nil {
+			t.Errorf("expected error to be returned for name '%s', but none was", name)
+		}
+	}
+}
+
+func TestEventBuilder_BuildWithInvalidPropertyReturnsError(t *testing.T) {
+	_, err := EventBuilder{}.
+		Name("trial_started").
+		Property("features", []string{"a", "b"}).
+		Build()
+	if err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
@@ -1,6 +1,7 @@
 package mailchimp
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -9,6 +10,11 @@ var (
 	NullListSlice = []List{}
 )
 
+const (
+	ListVisibilityPublic  = "pub"
+	ListVisibilityPrivate = "prv"
+)
+
 type Contact struct {
 	Address1 string `json:"address1" mc_validator:"required"`
 	Address2 string `json:"address2"`
@@ -27,6 +33,9 @@ type CampaignDefaults struct {
 	Language  string `json:"language" mc_validator:"required"`
 }
 
+// List is a MailChimp list, or audience. The ID, WebID, DateCreated,
+// ListRating, SubscribeURLShort, HasWelcome and Stats fields are
+// read-only and filled in by MailChimp.
 type List struct {
 	ID                   string           `json:"id"`
 	WebID                int              `json:"web_id"`
@@ -40,6 +49,135 @@ type List struct {
 	NotifyOnUnsubscribe  string           `json:"notify_on_unsubscribe"`
 	DoubleOptin          bool             `json:"double_optin"`
 	MarketingPermissions bool             `json:"marketing_permissions"`
+	Visibility           string           `json:"visibility,omitempty"`
+	DateCreated          string           `json:"date_created,omitempty"`
+	ListRating           int              `json:"list_rating,omitempty"`
+	SubscribeURLShort    string           `json:"subscribe_url_short,omitempty"`
+	HasWelcome           bool             `json:"has_welcome,omitempty"`
+	Stats                *ListStats       `json:"stats,omitempty"`
+}
+
+// listPayload holds the writable fields of a list, and is what is sent
+// to MailChimp by UpdateList. Fields that are empty or false are left
+// out, so that only the fields set on the list are updated.
+type listPayload struct {
+	Name                 string            `json:"name,omitempty"`
+	Contact              *Contact          `json:"contact,omitempty"`
+	PermissionReminder   string            `json:"permission_reminder,omitempty"`
+	CampaignDefaults     *CampaignDefaults `json:"campaign_defaults,omitempty"`
+	EmailTypeOption      bool              `json:"email_type_option,omitempty"`
+	UseArchiveBar        bool              `json:"use_archive_bar,omitempty"`
+	NotifyOnSubscribe    string            `json:"notify_on_subscribe,omitempty"`
+	NotifyOnUnsubscribe  string            `json:"notify_on_unsubscribe,omitempty"`
+	DoubleOptin          bool              `json:"double_optin,omitempty"`
+	MarketingPermissions bool              `json:"marketing_permissions,omitempty"`
+	Visibility           string            `json:"visibility,omitempty"`
+}
+
+func newListPayload(l List) listPayload {
+	payload := listPayload{
+		Name:                 l.Name,
+		PermissionReminder:   l.PermissionReminder,
+		EmailTypeOption:      l.EmailTypeOption,
+		UseArchiveBar:        l.UseArchiveBar,
+		NotifyOnSubscribe:    l.NotifyOnSubscribe,
+		NotifyOnUnsubscribe:  l.NotifyOnUnsubscribe,
+		DoubleOptin:          l.DoubleOptin,
+		MarketingPermissions: l.MarketingPermissions,
+		Visibility:           l.Visibility,
+	}
+	if l.Contact != (Contact{}) {
+		contact := l.Contact
+		payload.Contact = &contact
+	}
+	if l.CampaignDefaults != (CampaignDefaults{}) {
+		campaignDefaults := l.CampaignDefaults
+		payload.CampaignDefaults = &campaignDefaults
+	}
+	return payload
+}
+
+// ListUpdate is a partial update of a list, sent with UpdateListFields.
+// Only the fields that are not nil are sent to MailChimp, which means
+// that fields can be set to false or empty without affecting the rest
+// of the list. Use ListBuilder.BuildUpdate to create it.
+type ListUpdate struct {
+	Name                 *string           `json:"name,omitempty"`
+	Contact              *Contact          `json:"contact,omitempty"`
+	PermissionReminder   *string           `json:"permission_reminder,omitempty"`
+	CampaignDefaults     *CampaignDefaults `json:"campaign_defaults,omitempty"`
+	EmailTypeOption      *bool             `json:"email_type_option,omitempty"`
+	UseArchiveBar        *bool             `json:"use_archive_bar,omitempty"`
+	NotifyOnSubscribe    *string           `json:"notify_on_subscribe,omitempty"`
+	NotifyOnUnsubscribe  *string           `json:"notify_on_unsubscribe,omitempty"`
+	DoubleOptin          *bool             `json:"double_optin,omitempty"`
+	MarketingPermissions *bool             `json:"marketing_permissions,omitempty"`
+	Visibility           *string           `json:"visibility,omitempty"`
+}
+
+// ListStats holds the statistics of a list. It is read-only and only
+// set on lists that have been fetched from MailChimp. Dates are empty
+// if the event has not happened yet.
+type ListStats struct {
+	MemberCount               int     `json:"member_count"`
+	TotalContacts             int     `json:"total_contacts"`
+	UnsubscribeCount          int     `json:"unsubscribe_count"`
+	CleanedCount              int     `json:"cleaned_count"`
+	MemberCountSinceSend      int     `json:"member_count_since_send"`
+	UnsubscribeCountSinceSend int     `json:"unsubscribe_count_since_send"`
+	CleanedCountSinceSend     int     `json:"cleaned_count_since_send"`
+	CampaignCount             int     `json:"campaign_count"`
+	CampaignLastSent          string  `json:"campaign_last_sent"`
+	MergeFieldCount           int     `json:"merge_field_count"`
+	AvgSubRate                float64 `json:"avg_sub_rate"`
+	AvgUnsubRate              float64 `json:"avg_unsub_rate"`
+	TargetSubRate             float64 `json:"target_sub_rate"`
+	OpenRate                  float64 `json:"open_rate"`
+	ClickRate                 float64 `json:"click_rate"`
+	LastSubDate               string  `json:"last_sub_date"`
+	LastUnsubDate             string  `json:"last_unsub_date"`
+}
+
+// ListGrowth is the growth of a list during a month, formatted as
+// "2006-01".
+type ListGrowth struct {
+	ListID        string `json:"list_id"`
+	Month         string `json:"month"`
+	Existing      int    `json:"existing"`
+	Imports       int    `json:"imports"`
+	Optins        int    `json:"optins"`
+	Subscribed    int    `json:"subscribed"`
+	Unsubscribed  int    `json:"unsubscribed"`
+	Reconfirm     int    `json:"reconfirm"`
+	Cleaned       int    `json:"cleaned"`
+	Pending       int    `json:"pending"`
+	Deleted       int    `json:"deleted"`
+	Transactional int    `json:"transactional"`
+}
+
+type listGrowthCollection struct {
+	History    []ListGrowth `json:"history"`
+	TotalItems int          `json:"total_items"`
+}
+
+// ListActivity is the activity of a list during a day, formatted as
+// "2006-01-02".
+type ListActivity struct {
+	Day             string `json:"day"`
+	EmailsSent      int    `json:"emails_sent"`
+	UniqueOpens     int    `json:"unique_opens"`
+	RecipientClicks int    `json:"recipient_clicks"`
+	HardBounce      int    `json:"hard_bounce"`
+	SoftBounce      int    `json:"soft_bounce"`
+	Subs            int    `json:"subs"`
+	Unsubs          int    `json:"unsubs"`
+	OtherAdds       int    `json:"other_adds"`
+	OtherRemoves    int    `json:"other_removes"`
+}
+
+type listActivityCollection struct {
+	Activity   []ListActivity `json:"activity"`
+	TotalItems int            `json:"total_items"`
 }
 
 type listCollection struct {
@@ -47,7 +185,8 @@ type listCollection struct {
 }
 
 type ListBuilder struct {
-	obj List
+	obj    List
+	update ListUpdate
 }
 
 func (lb ListBuilder) Build() (List, error) {
@@ -72,27 +211,134 @@ func (lb ListBuilder) Build() (List, error) {
 	return lb.obj, nil
 }
 
+// BuildUpdate returns a ListUpdate for use with UpdateListFields,
+// holding only the fields set on the builder. Unlike Build it does not
+// require all the required fields to be set, but an error is returned
+// if no field has been set at all.
+func (lb ListBuilder) BuildUpdate() (ListUpdate, error) {
+	if lb.update == (ListUpdate{}) {
+		return ListUpdate{}, errors.New(
+			"could not build list update since no fields have been set",
+		)
+	}
+	return lb.update, nil
+}
+
 func (lb ListBuilder) Name(name string) ListBuilder {
 	lb.obj.Name = name
+	lb.update.Name = &name
 	return lb
 }
 
 func (lb ListBuilder) PermissionReminder(permissionReminder string) ListBuilder {
 	lb.obj.PermissionReminder = permissionReminder
+	lb.update.PermissionReminder = &permissionReminder
 	return lb
 }
 
 func (lb ListBuilder) EmailTypeOption(emailTypeOption bool) ListBuilder {
 	lb.obj.EmailTypeOption = emailTypeOption
+	lb.update.EmailTypeOption = &emailTypeOption
 	return lb
 }
 
 func (lb ListBuilder) Contact(c Contact) ListBuilder {
 	lb.obj.Contact = c
+	lb.update.Contact = &c
 	return lb
 }
 
 func (lb ListBuilder) CampaignDefaults(cd CampaignDefaults) ListBuilder {
 	lb.obj.CampaignDefaults = cd
+	lb.update.CampaignDefaults = &cd
+	return lb
+}
+
+func (lb ListBuilder) UseArchiveBar(useArchiveBar bool) ListBuilder {
+	lb.obj.UseArchiveBar = useArchiveBar
+	lb.update.UseArchiveBar = &useArchiveBar
+	return lb
+}
+
+func (lb ListBuilder) NotifyOnSubscribe(email string) ListBuilder {
+	lb.obj.NotifyOnSubscribe = email
+	lb.update.NotifyOnSubscribe = &email
+	return lb
+}
+
+func (lb ListBuilder) NotifyOnUnsubscribe(email string) ListBuilder {
+	lb.obj.NotifyOnUnsubscribe = email
+	lb.update.NotifyOnUnsubscribe = &email
+	return lb
+}
+
+func (lb ListBuilder) DoubleOptin(doubleOptin bool) ListBuilder {
+	lb.obj.DoubleOptin = doubleOptin
+	lb.update.DoubleOptin = &doubleOptin
 	return lb
 }
+
+func (lb ListBuilder) MarketingPermissions(marketingPermissions bool) ListBuilder {
+	lb.obj.MarketingPermissions = marketingPermissions
+	lb.update.MarketingPermissions = &marketingPermissions
+	return lb
+}
+
+func (lb ListBuilder) VisibilityPublic() ListBuilder {
+	visibility := ListVisibilityPublic
+	lb.obj.Visibility = visibility
+	lb.update.Visibility = &visibility
+	return lb
+}
+
+func (lb ListBuilder) VisibilityPrivate() ListBuilder {
+	visibility := ListVisibilityPrivate
+	lb.obj.Visibility = visibility
+	lb.update.Visibility = &visibility
+	return lb
+}
+
+// ListLocation is the share of list members in a country.
+type ListLocation struct {
+	Country string  `json:"country"`
+	CC      string  `json:"cc"`
+	Percent float64 `json:"percent"`
+	Total   int     `json:"total"`
+}
+
+type listLocationCollection struct {
+	Locations  []ListLocation `json:"locations"`
+	TotalItems int            `json:"total_items"`
+}
+
+// ListClient is the number of list members using an email client.
+type ListClient struct {
+	Client  string `json:"client"`
+	Members int    `json:"members"`
+}
+
+type listClientCollection struct {
+	Clients    []ListClient `json:"clients"`
+	TotalItems int          `json:"total_items"`
+}
+
+// AbuseReport is a complaint made by a list member about a campaign,
+// such as marking it as spam. EmailID is the ID of the member, which
+// can be used to fetch the member with FetchAbuseReportMember.
+type AbuseReport struct {
+	ID           int         `json:"id"`
+	CampaignID   string      `json:"campaign_id"`
+	ListID       string      `json:"list_id"`
+	EmailID      string      `json:"email_id"`
+	EmailAddress string      `json:"email_address"`
+	MergeFields  MergeFields `json:"merge_fields"`
+	VIP          bool        `json:"vip"`
+	Date         string      `json:"date"`
+}
+
+// AbuseReportCollection is a page of abuse reports. TotalItems is the
+// number of abuse reports on the list across all pages.
+type AbuseReportCollection struct {
+	AbuseReports []AbuseReport `json:"abuse_reports"`
+	TotalItems   int           `json:"total_items"`
+}
@@ -1,6 +1,9 @@
 package mailchimp
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestListBuilder_Name(t *testing.T) {
 	testName := "Foo bar"
@@ -134,3 +137,111 @@ func TestListBuilder_CampaignDefaults(t *testing.T) {
 		)
 	}
 }
+
+func TestList_UnmarshalStats(t *testing.T) {
+	list := List{}
+	raw := `{"id":"abc","stats":{"member_count":42,"open_rate":0.5,"last_sub_date":""}}`
+	if err := json.Unmarshal([]byte(raw), &list); err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if list.Stats == nil || list.Stats.MemberCount != 42 || list.Stats.OpenRate != 0.5 {
+		t.Errorf("expected stats to be decoded, but got %+v", list.Stats)
+	}
+}
+
+func TestList_MarshalWithoutStats(t *testing.T) {
+	raw, _ := json.Marshal(List{Name: "Test"})
+	fields := map[string]interface{}{}
+	json.Unmarshal(raw, &fields)
+	if _, ok := fields["stats"]; ok {
+		t.Error("expected stats to not be sent when they are not set")
+	}
+}
+
+func TestListBuilder_Setters(t *testing.T) {
+	builder := ListBuilder{}.
+		UseArchiveBar(true).
+		NotifyOnSubscribe("sub@test.com").
+		NotifyOnUnsubscribe("unsub@test.com").
+		DoubleOptin(true).
+		MarketingPermissions(true).
+		VisibilityPublic()
+	if !builder.obj.UseArchiveBar || !builder.obj.DoubleOptin || !builder.obj.MarketingPermissions {
+		t.Errorf("expected boolean fields to be set, but got %+v", builder.obj)
+	}
+	if builder.obj.NotifyOnSubscribe != "sub@test.com" || builder.obj.NotifyOnUnsubscribe != "unsub@test.com" {
+		t.Errorf("expected notification emails to be set, but got %+v", builder.obj)
+	}
+	if builder.obj.Visibility != ListVisibilityPublic {
+		t.Errorf(
+			"expected visibility to be '%s', but was '%s'",
+			ListVisibilityPublic,
+			builder.obj.Visibility,
+		)
+	}
+	builder = builder.VisibilityPrivate()
+	if builder.obj.Visibility != ListVisibilityPrivate {
+		t.Errorf(
+			"expected visibility to be '%s', but was '%s'",
+			ListVisibilityPrivate,
+			builder.obj.Visibility,
+		)
+	}
+}
+
+func TestListBuilder_BuildUpdateWithoutFieldsReturnsError(t *testing.T) {
+	if _, err := (ListBuilder{}).BuildUpdate(); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestNewListPayload_SendsOnlySetFields(t *testing.T) {
+	list := List{
+		ID:          "abc",
+		Name:        "Test",
+		DateCreated: "2021-03-01",
+		Contact:     Contact{Company: "Test AB"},
+		DoubleOptin: true,
+	}
+	raw, err := json.Marshal(newListPayload(list))
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	payload := map[string]json.RawMessage{}
+	json.Unmarshal(raw, &payload)
+	if len(payload) != 3 {
+		t.Errorf("expected 3 fields to be sent, but got %s", raw)
+	}
+	for _, key := range []string{"name", "contact", "double_optin"} {
+		if _, ok := payload[key]; !ok {
+			t.Errorf("expected field '%s' to be sent", key)
+		}
+	}
+}
+
+func TestListBuilder_BuildUpdateHoldsOnlySetFields(t *testing.T) {
+	update, err := ListBuilder{}.Name("Test").UseArchiveBar(false).BuildUpdate()
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if update.Name == nil || *update.Name != "Test" {
+		t.Errorf("expected name to be 'Test', but was %v", update.Name)
+	}
+	if update.UseArchiveBar == nil || *update.UseArchiveBar {
+		t.Errorf("expected use archive bar to be false, but was %v", update.UseArchiveBar)
+	}
+	if update.Contact != nil || update.DoubleOptin != nil {
+		t.Errorf("expected fields not set on the builder to be nil, but got %+v", update)
+	}
+}
+
+func TestList_UnmarshalReadOnlyFields(t *testing.T) {
+	list := List{}
+	raw := `{"date_created":"2021-03-01T12:00:00+00:00","list_rating":4,"subscribe_url_short":"http://eepurl.com/x","visibility":"pub","has_welcome":true}`
+	if err := json.Unmarshal([]byte(raw), &list); err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if list.ListRating != 4 || !list.HasWelcome || list.Visibility != ListVisibilityPublic || list.SubscribeURLShort == "" {
+		t.Errorf("expected read-only fields to be decoded, but got %+v", list)
+	}
+}
@@ -1,23 +1,208 @@
 package mailchimp
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"time"
 )
 
+// MemberStatus is the subscription status of a member. Decoding a
+// status that is not one of the known statuses returns an error.
+type MemberStatus string
+
 const (
-	StatusSubscribed   = "subscribed"
-	StatusUnsubscribed = "unsubscribed"
-	StatusPending      = "pending"
-	StatusCleaned      = "cleaned"
+	StatusSubscribed    MemberStatus = "subscribed"
+	StatusUnsubscribed  MemberStatus = "unsubscribed"
+	StatusPending       MemberStatus = "pending"
+	StatusCleaned       MemberStatus = "cleaned"
+	StatusTransactional MemberStatus = "transactional"
+	StatusArchived      MemberStatus = "archived"
 )
 
+func (s *MemberStatus) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	status := MemberStatus(raw)
+	if status != "" && !status.known() {
+		return fmt.Errorf("unknown member status '%s'", raw)
+	}
+	*s = status
+	return nil
+}
+
+func (s MemberStatus) known() bool {
+	switch s {
+	case StatusSubscribed,
+		StatusUnsubscribed,
+		StatusPending,
+		StatusCleaned,
+		StatusTransactional,
+		StatusArchived:
+		return true
+	}
+	return false
+}
+
 var NullMember = Member{}
 
+// Member is a contact on a list. Only EmailAddress, EmailType, Status,
+// MergeFields, Language, VIP and MarketingPermissions are sent to
+// MailChimp when creating or updating a member, the remaining fields
+// are read-only and filled in by MailChimp.
 type Member struct {
-	EmailAddress string            `json:"email_address" mc_validator:"required"`
-	EmailType    string            `json:"email_type"`
-	Status       string            `json:"status"`
-	MergeFields  map[string]string `json:"merge_fields"`
+	ID                   string                `json:"id"`
+	EmailAddress         string                `json:"email_address" mc_validator:"required"`
+	UniqueEmailID        string                `json:"unique_email_id"`
+	ContactID            string                `json:"contact_id"`
+	WebID                int                   `json:"web_id"`
+	EmailType            string                `json:"email_type"`
+	Status               MemberStatus          `json:"status"`
+	MergeFields          MergeFields           `json:"merge_fields"`
+	Stats                MemberStats           `json:"stats"`
+	IPSignup             string                `json:"ip_signup"`
+	TimestampSignup      string                `json:"timestamp_signup"` // empty if unknown
+	IPOpt                string                `json:"ip_opt"`
+	TimestampOpt         string                `json:"timestamp_opt"` // empty if unknown
+	MemberRating         int                   `json:"member_rating"`
+	LastChanged          time.Time             `json:"last_changed"`
+	Language             string                `json:"language"`
+	VIP                  *bool                 `json:"vip,omitempty"` // nil if not set by the builder
+	Location             MemberLocation        `json:"location"`
+	MarketingPermissions []MarketingPermission `json:"marketing_permissions"`
+	Source               string                `json:"source"`
+	TagsCount            int                   `json:"tags_count"`
+	ListID               string                `json:"list_id"`
+}
+
+// MergeFields holds the merge fields of a member keyed by merge tag.
+// Text fields are decoded as strings, NUMBER fields as json.Number and
+// ADDRESS fields as map[string]interface{}. Use String and Address to
+// read a field without checking its type.
+type MergeFields map[string]interface{}
+
+func (mf *MergeFields) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	fields := map[string]interface{}{}
+	if err := decoder.Decode(&fields); err != nil {
+		return err
+	}
+	*mf = fields
+	return nil
+}
+
+// String returns the merge field with the given name as a string.
+// Fields that are not text, such as numbers and addresses, are returned
+// as JSON. An empty string is returned if the field is not set.
+func (mf MergeFields) String(name string) string {
+	switch value := mf[name].(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return ""
+		}
+		return string(raw)
+	}
+}
+
+// Address returns the ADDRESS merge field with the given name. The
+// boolean is false if the field is not set or is not an address.
+func (mf MergeFields) Address(name string) (MergeAddress, bool) {
+	value, ok := mf[name].(map[string]interface{})
+	if !ok {
+		return MergeAddress{}, false
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return MergeAddress{}, false
+	}
+	address := MergeAddress{}
+	if err := json.Unmarshal(raw, &address); err != nil {
+		return MergeAddress{}, false
+	}
+	return address, true
+}
+
+// MergeAddress is the value of an ADDRESS merge field.
+type MergeAddress struct {
+	Addr1   string `json:"addr1"`
+	Addr2   string `json:"addr2"`
+	City    string `json:"city"`
+	State   string `json:"state"`
+	Zip     string `json:"zip"`
+	Country string `json:"country"`
+}
+
+type MemberStats struct {
+	AvgOpenRate  float64 `json:"avg_open_rate"`
+	AvgClickRate float64 `json:"avg_click_rate"`
+}
+
+type MemberLocation struct {
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	GMTOff      int     `json:"gmtoff"`
+	DSTOff      int     `json:"dstoff"`
+	CountryCode string  `json:"country_code"`
+	Timezone    string  `json:"timezone"`
+	Region      string  `json:"region"`
+}
+
+type MarketingPermission struct {
+	MarketingPermissionID string `json:"marketing_permission_id"`
+	Text                  string `json:"text"`
+	Enabled               bool   `json:"enabled"`
+}
+
+// memberPayload holds the writable fields of a member, and is what is
+// sent to MailChimp when a single member is updated. VIP is only sent
+// when it has been set, so that an update does not clear the flag.
+type memberPayload struct {
+	EmailAddress         string                       `json:"email_address"`
+	EmailType            string                       `json:"email_type"`
+	Status               MemberStatus                 `json:"status"`
+	MergeFields          MergeFields                  `json:"merge_fields"`
+	Language             string                       `json:"language,omitempty"`
+	VIP                  *bool                        `json:"vip,omitempty"`
+	MarketingPermissions []marketingPermissionPayload `json:"marketing_permissions,omitempty"`
+}
+
+type marketingPermissionPayload struct {
+	MarketingPermissionID string `json:"marketing_permission_id"`
+	Enabled               bool   `json:"enabled"`
+}
+
+func newMemberPayload(m Member) memberPayload {
+	return memberPayload{
+		EmailAddress:         m.EmailAddress,
+		EmailType:            m.EmailType,
+		Status:               m.Status,
+		MergeFields:          m.MergeFields,
+		Language:             m.Language,
+		VIP:                  m.VIP,
+		MarketingPermissions: newMarketingPermissionPayloads(m.MarketingPermissions),
+	}
+}
+
+func newMarketingPermissionPayloads(permissions []MarketingPermission) []marketingPermissionPayload {
+	if len(permissions) == 0 {
+		return nil
+	}
+	payloads := make([]marketingPermissionPayload, 0, len(permissions))
+	for _, permission := range permissions {
+		payloads = append(payloads, marketingPermissionPayload{
+			MarketingPermissionID: permission.MarketingPermissionID,
+			Enabled:               permission.Enabled,
+		})
+	}
+	return payloads
 }
 
 func (m Member) Subscribed() bool {
@@ -36,6 +221,14 @@ func (m Member) Cleaned() bool {
 	return m.Status == StatusCleaned
 }
 
+func (m Member) Transactional() bool {
+	return m.Status == StatusTransactional
+}
+
+func (m Member) Archived() bool {
+	return m.Status == StatusArchived
+}
+
 type MemberBuilder struct {
 	obj Member
 }
@@ -81,10 +274,114 @@ func (sb MemberBuilder) StatusCleaned() MemberBuilder {
 	return sb
 }
 
+func (sb MemberBuilder) StatusTransactional() MemberBuilder {
+	sb.obj.Status = StatusTransactional
+	return sb
+}
+
 func (sb MemberBuilder) MergeField(name string, value string) MemberBuilder {
 	if sb.obj.MergeFields == nil {
-		sb.obj.MergeFields = make(map[string]string)
+		sb.obj.MergeFields = make(MergeFields)
 	}
 	sb.obj.MergeFields[name] = value
 	return sb
 }
+
+// MemberSearchResults holds the outcome of SearchMembers. Members whose
+// email address matches the query exactly are listed in ExactMatches,
+// while FullSearch holds partial matches on email addresses and names.
+type MemberSearchResults struct {
+	ExactMatches []Member
+	FullSearch   []Member
+}
+
+type memberSearchResponse struct {
+	ExactMatches memberCollection `json:"exact_matches"`
+	FullSearch   memberCollection `json:"full_search"`
+}
+
+// MarketingPermission sets the consent of the member for the marketing
+// permission of the given ID. The IDs differ between lists, and can be
+// looked up by their text using FetchMarketingPermissionIDs.
+// VIP sets whether the member is a VIP. The flag is left untouched on
+// updates unless it is set.
+func (sb MemberBuilder) VIP(vip bool) MemberBuilder {
+	sb.obj.VIP = &vip
+	return sb
+}
+
+func (sb MemberBuilder) MarketingPermission(id string, enabled bool) MemberBuilder {
+	permissions := make([]MarketingPermission, 0, len(sb.obj.MarketingPermissions)+1)
+	for _, permission := range sb.obj.MarketingPermissions {
+		if permission.MarketingPermissionID != id {
+			permissions = append(permissions, permission)
+		}
+	}
+	sb.obj.MarketingPermissions = append(permissions, MarketingPermission{
+		MarketingPermissionID: id,
+		Enabled:               enabled,
+	})
+	return sb
+}
+
+// MarketingPermission returns the consent of the member for the
+// marketing permission of the given ID, and whether the member has
+// the permission at all.
+func (m Member) MarketingPermission(id string) (enabled bool, found bool) {
+	for _, permission := range m.MarketingPermissions {
+		if permission.MarketingPermissionID == id {
+			return permission.Enabled, true
+		}
+	}
+	return false, false
+}
+
+type memberCollection struct {
+	Members    []Member `json:"members"`
+	TotalItems int      `json:"total_items"`
+}
+
+// memberIteratorPageSize is the number of members fetched per request
+// by a MemberIterator.
+const memberIteratorPageSize = 500
+
+// MemberIterator pages through a collection of members, fetching the
+// next page from MailChimp when the current one has been consumed.
+// Call Next to advance the iterator and Member to get the current
+// member. Once Next returns false, Err reports whether the iteration
+// stopped because of an error.
+type MemberIterator struct {
+	pager   pager
+	page    []Member
+	current Member
+}
+
+func newMemberIterator(fetch func(page Page) ([]Member, int, error)) *MemberIterator {
+	it := &MemberIterator{}
+	it.pager = pager{
+		size: memberIteratorPageSize,
+		fetch: func(page Page) (int, int, error) {
+			members, total, err := fetch(page)
+			it.page = members
+			return len(members), total, err
+		},
+	}
+	return it
+}
+
+func (it *MemberIterator) Next() bool {
+	if len(it.page) == 0 && !it.pager.more() {
+		return false
+	}
+	it.current = it.page[0]
+	it.page = it.page[1:]
+	return true
+}
+
+func (it *MemberIterator) Member() Member {
+	return it.current
+}
+
+func (it *MemberIterator) Err() error {
+	return it.pager.err
+}
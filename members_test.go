@@ -1,6 +1,9 @@
 package mailchimp
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestMemberBuilder_AddEmailAddress(t *testing.T) {
 	testEmailAddress := "test@testsson.com"
@@ -58,6 +61,13 @@ func TestMemberBuilder_AddStatus(t *testing.T) {
 			builder.obj.Status,
 		)
 	}
+	builder = builder.StatusTransactional()
+	if builder.obj.Status != "transactional" {
+		t.Errorf(
+			"expected status to be 'transactional' but was '%s'",
+			builder.obj.Status,
+		)
+	}
 }
 
 func TestMemberBuilder_AddMergeField(t *testing.T) {
@@ -150,3 +160,175 @@ func TestMember_StatusPending(t *testing.T) {
 		t.Error("expected member.Pending to return true but returned false")
 	}
 }
+
+func TestMember_UnmarshalReadOnlyFields(t *testing.T) {
+	raw := `{
+		"id": "abc",
+		"email_address": "test@test.com",
+		"unique_email_id": "u1",
+		"contact_id": "c1",
+		"web_id": 12,
+		"status": "subscribed",
+		"stats": {"avg_open_rate": 0.5, "avg_click_rate": 0.25},
+		"ip_signup": "127.0.0.1",
+		"timestamp_opt": "",
+		"member_rating": 3,
+		"last_changed": "2021-03-01T12:00:00+00:00",
+		"vip": true,
+		"location": {"country_code": "SE", "timezone": "Europe/Stockholm"},
+		"marketing_permissions": [{"marketing_permission_id": "p1", "text": "Email", "enabled": true}],
+		"source": "API - Generic",
+		"tags_count": 2
+	}`
+	member := Member{}
+	if err := json.Unmarshal([]byte(raw), &member); err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if member.ID != "abc" || member.WebID != 12 || member.MemberRating != 3 || member.TagsCount != 2 {
+		t.Errorf("expected read-only fields to be decoded, but got %+v", member)
+	}
+	if member.Stats.AvgOpenRate != 0.5 || member.Location.CountryCode != "SE" || member.VIP == nil || !*member.VIP {
+		t.Errorf("expected nested fields to be decoded, but got %+v", member)
+	}
+	if len(member.MarketingPermissions) != 1 || !member.MarketingPermissions[0].Enabled {
+		t.Errorf("expected marketing permissions to be decoded, but got %v", member.MarketingPermissions)
+	}
+}
+
+func TestMergeFields_StringOfMissingField(t *testing.T) {
+	if value := (MergeFields{}).String("FNAME"); value != "" {
+		t.Errorf("expected missing field to be empty, but was '%s'", value)
+	}
+}
+
+func TestMergeFields_AddressOfTextField(t *testing.T) {
+	if _, ok := (MergeFields{"FNAME": "Test"}).Address("FNAME"); ok {
+		t.Error("expected text field to not be an address")
+	}
+}
+
+func TestNewMemberPayload_ExcludesReadOnlyFields(t *testing.T) {
+	member := Member{
+		ID:           "abc",
+		EmailAddress: "test@test.com",
+		Status:       StatusSubscribed,
+		MemberRating: 3,
+		TagsCount:    2,
+	}
+	raw, err := json.Marshal(newMemberPayload(member))
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	fields := map[string]interface{}{}
+	json.Unmarshal(raw, &fields)
+	for _, field := range []string{"id", "member_rating", "tags_count", "stats", "last_changed"} {
+		if _, ok := fields[field]; ok {
+			t.Errorf("expected read-only field '%s' to not be sent", field)
+		}
+	}
+}
+
+func TestNewMemberPayload_LeavesOutUnsetVIP(t *testing.T) {
+	member, _ := MemberBuilder{}.EmailAddress("test@test.com").Build()
+	raw, err := json.Marshal(newMemberPayload(member))
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	fields := map[string]interface{}{}
+	json.Unmarshal(raw, &fields)
+	if _, ok := fields["vip"]; ok {
+		t.Errorf("expected vip to not be sent, but got %s", raw)
+	}
+}
+
+func TestNewMemberPayload_SendsExplicitVIPFalse(t *testing.T) {
+	member, _ := MemberBuilder{}.EmailAddress("test@test.com").VIP(false).Build()
+	raw, err := json.Marshal(newMemberPayload(member))
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	fields := map[string]interface{}{}
+	json.Unmarshal(raw, &fields)
+	if vip, ok := fields["vip"]; !ok || vip != false {
+		t.Errorf("expected vip to be sent as false, but got %s", raw)
+	}
+}
+
+func TestMemberBuilder_MarketingPermission(t *testing.T) {
+	member, _ := MemberBuilder{}.
+		EmailAddress("test@test.com").
+		MarketingPermission("p1", true).
+		MarketingPermission("p2", true).
+		MarketingPermission("p1", false).
+		Build()
+	if len(member.MarketingPermissions) != 2 {
+		t.Fatalf(
+			"expected 2 marketing permissions but found %d",
+			len(member.MarketingPermissions),
+		)
+	}
+	if enabled, found := member.MarketingPermission("p1"); !found || enabled {
+		t.Error("expected marketing permission 'p1' to have been disabled")
+	}
+	if enabled, found := member.MarketingPermission("p2"); !found || !enabled {
+		t.Error("expected marketing permission 'p2' to be enabled")
+	}
+	if _, found := member.MarketingPermission("p3"); found {
+		t.Error("expected marketing permission 'p3' to not be found")
+	}
+}
+
+func TestMember_StatusTransactional(t *testing.T) {
+	member := Member{Status: "transactional"}
+	if !member.Transactional() {
+		t.Error("expected member.Transactional to return true but returned false")
+	}
+	if member.Archived() {
+		t.Error("expected member.Archived to return false but returned true")
+	}
+	if member.Subscribed() {
+		t.Error("expected member.Subscribed to return false but returned true")
+	}
+}
+
+func TestMember_StatusArchived(t *testing.T) {
+	member := Member{Status: "archived"}
+	if !member.Archived() {
+		t.Error("expected member.Archived to return true but returned false")
+	}
+	if member.Transactional() {
+		t.Error("expected member.Transactional to return false but returned true")
+	}
+}
+
+func TestMemberStatus_UnmarshalJSON(t *testing.T) {
+	member := Member{}
+	if err := json.Unmarshal([]byte(`{"status":"transactional"}`), &member); err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if member.Status != StatusTransactional {
+		t.Errorf("expected status to be 'transactional' but was '%s'", member.Status)
+	}
+	if err := json.Unmarshal([]byte(`{"status":"banana"}`), &member); err == nil {
+		t.Error("expected error to be returned for unknown status, but none was")
+	}
+}
+
+func TestMemberIterator_YieldsEveryMemberOfEveryPage(t *testing.T) {
+	it := newMemberIterator(func(page Page) ([]Member, int, error) {
+		if page.Offset == 0 {
+			return []Member{{EmailAddress: "a@test.com"}, {EmailAddress: "b@test.com"}}, 3, nil
+		}
+		return []Member{{EmailAddress: "c@test.com"}}, 3, nil
+	})
+	emails := make([]string, 0)
+	for it.Next() {
+		emails = append(emails, it.Member().EmailAddress)
+	}
+	if it.Err() != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", it.Err().Error())
+	}
+	if len(emails) != 3 || emails[2] != "c@test.com" {
+		t.Errorf("expected 3 members but found %v", emails)
+	}
+}
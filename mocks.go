@@ -1,5 +1,7 @@
 package mailchimp
 
+import "time"
+
 type MailChimpProviderMock struct {
 	PostMock    func(string, interface{}) ([]byte, error)
 	PostCalls   int
@@ -7,6 +9,8 @@ type MailChimpProviderMock struct {
 	GetCalls    int
 	PatchMock   func(string, interface{}) ([]byte, error)
 	PatchCalls  int
+	PutMock     func(string, interface{}) ([]byte, error)
+	PutCalls    int
 	DeleteMock  func(string) ([]byte, error)
 	DeleteCalls int
 }
@@ -26,25 +30,69 @@ func (mcpm *MailChimpProviderMock) Patch(uri string, body interface{}) ([]byte,
 	return mcpm.PatchMock(uri, body)
 }
 
+func (mcpm *MailChimpProviderMock) Put(uri string, body interface{}) ([]byte, error) {
+	mcpm.PutCalls++
+	return mcpm.PutMock(uri, body)
+}
+
 func (mcpm *MailChimpProviderMock) Delete(uri string) ([]byte, error) {
 	mcpm.DeleteCalls++
 	return mcpm.DeleteMock(uri)
 }
 
+// NewMemberIteratorMock returns an iterator over the given members for
+// use in mocked client functions. If err is not nil, the iterator
+// yields no members and Err returns err.
+func NewMemberIteratorMock(members []Member, err error) *MemberIterator {
+	if err != nil {
+		return &MemberIterator{pager: pager{err: err}}
+	}
+	return &MemberIterator{page: members}
+}
+
+// NewEmailActivityIteratorMock returns an iterator over the given
+// email activity for use in mocked client functions. If err is not
+// nil, the iterator yields no activity and Err returns err.
+func NewEmailActivityIteratorMock(emails []EmailActivity, err error) *EmailActivityIterator {
+	if err != nil {
+		return &EmailActivityIterator{pager: pager{err: err}}
+	}
+	return &EmailActivityIterator{page: emails}
+}
+
 type ClientMock struct {
 	PingMock  func() error
 	PingCalls int
 
-	CreateListMock  func(List) (List, error)
-	CreateListCalls int
-	FetchListsMock  func() ([]List, error)
-	FetchListsCalls int
-	FetchListMock   func(string) (List, error)
-	FetchListCalls  int
-	UpdateListMock  func(string, List) (List, error)
-	UpdateListCalls int
-	DeleteListMock  func(string) error
-	DeleteListCalls int
+	CreateListMock        func(List) (List, error)
+	CreateListCalls       int
+	FetchListsMock        func() ([]List, error)
+	FetchListsCalls       int
+	FetchListMock         func(string) (List, error)
+	FetchListCalls        int
+	UpdateListMock        func(string, List) (List, error)
+	UpdateListCalls       int
+	UpdateListFieldsMock  func(string, ListUpdate) (List, error)
+	UpdateListFieldsCalls int
+	DeleteListMock        func(string) error
+	DeleteListCalls       int
+
+	FetchListGrowthHistoryMock  func(string) ([]ListGrowth, error)
+	FetchListGrowthHistoryCalls int
+	FetchListActivityMock       func(string) ([]ListActivity, error)
+	FetchListActivityCalls      int
+	FetchListLocationsMock      func(string) ([]ListLocation, error)
+	FetchListLocationsCalls     int
+	FetchListClientsMock        func(string) ([]ListClient, error)
+	FetchListClientsCalls       int
+	FetchAbuseReportsMock       func(string, Page) (AbuseReportCollection, error)
+	FetchAbuseReportsCalls      int
+	FetchAbuseReportMemberMock  func(AbuseReport) (Member, error)
+	FetchAbuseReportMemberCalls int
+	FetchSignupFormsMock        func(string) ([]SignupForm, error)
+	FetchSignupFormsCalls       int
+	CustomizeSignupFormMock     func(string, SignupForm) (SignupForm, error)
+	CustomizeSignupFormCalls    int
 
 	BatchMock            func(string, []Member) error
 	BatchCalls           int
@@ -53,19 +101,164 @@ type ClientMock struct {
 	BatchOperationsMock  func(OperationCollection) error
 	BatchOperationsCalls int
 
+	FetchMarketingPermissionIDsMock  func(string) (map[string]string, error)
+	FetchMarketingPermissionIDsCalls int
+	SearchMembersMock                func(string, string) (MemberSearchResults, error)
+	SearchMembersCalls               int
+
+	FetchMemberMock   func(string, string) (Member, error)
+	FetchMemberCalls  int
 	UpdateMemberMock  func(string, string, Member) error
 	UpdateMemberCalls int
 
-	FetchMemberTagsMock       func(string, string) ([]Tag, error)
-	FetchMemberTagsCalls      int
-	UpdateMemberTagsMock      func(string, string, []Tag) error
-	UpdateMemberTagsCalls     int
-	UpdateMemberTagsSyncMock  func(string, string, []Tag) error
-	UpdateMemberTagsSyncCalls int
+	FetchMemberTagsMock          func(string, string) ([]Tag, error)
+	FetchMemberTagsCalls         int
+	UpdateMemberTagsMock         func(string, string, []Tag) error
+	UpdateMemberTagsCalls        int
+	UpdateMemberTagsSyncMock     func(string, string, []Tag) error
+	UpdateMemberTagsSyncCalls    int
+	FetchMembersByTagMock        func(string, string) *MemberIterator
+	FetchMembersByTagCalls       int
+	SetMemberTagsMock            func(string, string, []string) error
+	SetMemberTagsCalls           int
+	SetMemberTagsWithPrefixMock  func(string, string, string, []string) error
+	SetMemberTagsWithPrefixCalls int
+	SearchListTagsMock           func(string, string) ([]ListTag, error)
+	SearchListTagsCalls          int
+	TagMembersMock               func(string, string, []string, bool) ([]TagMemberResult, error)
+	TagMembersCalls              int
+
+	CreateMemberNoteMock  func(string, string, string) (Note, error)
+	CreateMemberNoteCalls int
+	FetchMemberNotesMock  func(string, string) ([]Note, error)
+	FetchMemberNotesCalls int
+	UpdateMemberNoteMock  func(string, string, int, string) (Note, error)
+	UpdateMemberNoteCalls int
+	DeleteMemberNoteMock  func(string, string, int) error
+	DeleteMemberNoteCalls int
+
+	CreateMemberEventMock  func(string, string, Event) error
+	CreateMemberEventCalls int
+	FetchMemberEventsMock  func(string, string) ([]Event, error)
+	FetchMemberEventsCalls int
+
+	FetchMemberActivityMock  func(string, string, MemberActivityFilters) (MemberActivityFeed, error)
+	FetchMemberActivityCalls int
 
 	ArchiveMemberMock  func(string, string) error
 	ArchiveMemberCalls int
 
+	CreateCampaignMock        func(Campaign) (Campaign, error)
+	CreateCampaignCalls       int
+	FetchCampaignsMock        func(CampaignFilters) ([]Campaign, error)
+	FetchCampaignsCalls       int
+	FetchCampaignMock         func(string) (Campaign, error)
+	FetchCampaignCalls        int
+	UpdateCampaignMock        func(string, Campaign) (Campaign, error)
+	UpdateCampaignCalls       int
+	DeleteCampaignMock        func(string) error
+	DeleteCampaignCalls       int
+	CreateCampaignFolderMock  func(string) (CampaignFolder, error)
+	CreateCampaignFolderCalls int
+	FetchCampaignFoldersMock  func(Page) ([]CampaignFolder, error)
+	FetchCampaignFoldersCalls int
+	FetchCampaignFolderMock   func(string) (CampaignFolder, error)
+	FetchCampaignFolderCalls  int
+	UpdateCampaignFolderMock  func(string, string) (CampaignFolder, error)
+	UpdateCampaignFolderCalls int
+	DeleteCampaignFolderMock  func(string) error
+	DeleteCampaignFolderCalls int
+
+	SetCampaignContentMock    func(string, CampaignContent) (RenderedCampaignContent, error)
+	SetCampaignContentCalls   int
+	FetchCampaignContentMock  func(string) (RenderedCampaignContent, error)
+	FetchCampaignContentCalls int
+
+	SendCampaignMock               func(string) error
+	SendCampaignCalls              int
+	SendCampaignWithChecklistMock  func(string) error
+	SendCampaignWithChecklistCalls int
+	FetchSendChecklistMock         func(string) (SendChecklist, error)
+	FetchSendChecklistCalls        int
+	SendTestEmailMock              func(string, []string, string) error
+	SendTestEmailCalls             int
+	ScheduleCampaignMock           func(string, time.Time, bool, *BatchDelivery) error
+	ScheduleCampaignCalls          int
+	UnscheduleCampaignMock         func(string) error
+	UnscheduleCampaignCalls        int
+	PauseCampaignMock              func(string) error
+	PauseCampaignCalls             int
+	ResumeCampaignMock             func(string) error
+	ResumeCampaignCalls            int
+	ReplicateCampaignMock          func(string) (Campaign, error)
+	ReplicateCampaignCalls         int
+	CancelSendMock                 func(string) error
+	CancelSendCalls                int
+
+	FetchCampaignReportMock           func(string) (CampaignReport, error)
+	FetchCampaignReportCalls          int
+	FetchReportClickDetailsMock       func(string, Page) (ClickDetailCollection, error)
+	FetchReportClickDetailsCalls      int
+	FetchReportOpenDetailsMock        func(string, Page) (OpenDetailCollection, error)
+	FetchReportOpenDetailsCalls       int
+	FetchReportEmailActivityMock      func(string, Page) (EmailActivityCollection, error)
+	FetchReportEmailActivityCalls     int
+	StreamEmailActivityMock           func(string, time.Time) *EmailActivityIterator
+	StreamEmailActivityCalls          int
+	FetchReportUnsubscribesMock       func(string, Page) (UnsubscribeCollection, error)
+	FetchReportUnsubscribesCalls      int
+	FetchReportSentToMock             func(string, Page) (SentToCollection, error)
+	FetchReportSentToCalls            int
+	FetchReportDomainPerformanceMock  func(string) ([]DomainPerformance, error)
+	FetchReportDomainPerformanceCalls int
+	FetchReportLocationsMock          func(string, Page) (OpenLocationCollection, error)
+	FetchReportLocationsCalls         int
+
+	CreateTemplateMock               func(Template) (Template, error)
+	CreateTemplateCalls              int
+	FetchTemplatesMock               func(TemplateFilters) ([]Template, error)
+	FetchTemplatesCalls              int
+	FetchTemplateMock                func(int) (Template, error)
+	FetchTemplateCalls               int
+	UpdateTemplateMock               func(int, Template) (Template, error)
+	UpdateTemplateCalls              int
+	DeleteTemplateMock               func(int) error
+	DeleteTemplateCalls              int
+	FetchTemplateDefaultContentMock  func(int) (TemplateDefaultContent, error)
+	FetchTemplateDefaultContentCalls int
+	SyncTemplateMock                 func(Template) (Template, error)
+	SyncTemplateCalls                int
+	SyncTemplateFromFileMock         func(string, string) (Template, error)
+	SyncTemplateFromFileCalls        int
+	CreateTemplateFolderMock         func(string) (TemplateFolder, error)
+	CreateTemplateFolderCalls        int
+	FetchTemplateFoldersMock         func(Page) ([]TemplateFolder, error)
+	FetchTemplateFoldersCalls        int
+	FetchTemplateFolderMock          func(string) (TemplateFolder, error)
+	FetchTemplateFolderCalls         int
+	UpdateTemplateFolderMock         func(string, string) (TemplateFolder, error)
+	UpdateTemplateFolderCalls        int
+	DeleteTemplateFolderMock         func(string) error
+	DeleteTemplateFolderCalls        int
+
+	FetchAutomationsMock                func(Page) ([]Automation, error)
+	FetchAutomationsCalls               int
+	FetchAutomationMock                 func(string) (Automation, error)
+	FetchAutomationCalls                int
+	StartAutomationMock                 func(string) error
+	StartAutomationCalls                int
+	PauseAutomationMock                 func(string) error
+	PauseAutomationCalls                int
+	FetchAutomationEmailsMock           func(string) ([]AutomationEmail, error)
+	FetchAutomationEmailsCalls          int
+	AddSubscriberToAutomationQueueMock  func(string, string, string) error
+	AddSubscriberToAutomationQueueCalls int
+	RemoveSubscriberFromAutomationMock  func(string, string) error
+	RemoveSubscriberFromAutomationCalls int
+
+	TriggerJourneyStepMock  func(int, int, string) error
+	TriggerJourneyStepCalls int
+
 	CreateWebhookMock  func(webhook Webhook) (Webhook, error)
 	CreateWebhookCalls int
 	FetchWebhooksMock  func(listID string) ([]Webhook, error)
@@ -101,11 +294,56 @@ func (client *ClientMock) UpdateList(id string, list List) (List, error) {
 	return client.UpdateListMock(id, list)
 }
 
+func (client *ClientMock) UpdateListFields(id string, update ListUpdate) (List, error) {
+	client.UpdateListFieldsCalls++
+	return client.UpdateListFieldsMock(id, update)
+}
+
 func (client *ClientMock) DeleteList(id string) error {
 	client.DeleteListCalls++
 	return client.DeleteListMock(id)
 }
 
+func (client *ClientMock) FetchListGrowthHistory(id string) ([]ListGrowth, error) {
+	client.FetchListGrowthHistoryCalls++
+	return client.FetchListGrowthHistoryMock(id)
+}
+
+func (client *ClientMock) FetchListActivity(id string) ([]ListActivity, error) {
+	client.FetchListActivityCalls++
+	return client.FetchListActivityMock(id)
+}
+
+func (client *ClientMock) FetchListLocations(id string) ([]ListLocation, error) {
+	client.FetchListLocationsCalls++
+	return client.FetchListLocationsMock(id)
+}
+
+func (client *ClientMock) FetchListClients(id string) ([]ListClient, error) {
+	client.FetchListClientsCalls++
+	return client.FetchListClientsMock(id)
+}
+
+func (client *ClientMock) FetchAbuseReports(id string, page Page) (AbuseReportCollection, error) {
+	client.FetchAbuseReportsCalls++
+	return client.FetchAbuseReportsMock(id, page)
+}
+
+func (client *ClientMock) FetchAbuseReportMember(report AbuseReport) (Member, error) {
+	client.FetchAbuseReportMemberCalls++
+	return client.FetchAbuseReportMemberMock(report)
+}
+
+func (client *ClientMock) FetchSignupForms(id string) ([]SignupForm, error) {
+	client.FetchSignupFormsCalls++
+	return client.FetchSignupFormsMock(id)
+}
+
+func (client *ClientMock) CustomizeSignupForm(id string, form SignupForm) (SignupForm, error) {
+	client.CustomizeSignupFormCalls++
+	return client.CustomizeSignupFormMock(id, form)
+}
+
 func (client *ClientMock) Batch(id string, members []Member) error {
 	client.BatchCalls++
 	return client.BatchMock(id, members)
@@ -121,6 +359,21 @@ func (client *ClientMock) BatchOperations(operations OperationCollection) error
 	return client.BatchOperationsMock(operations)
 }
 
+func (client *ClientMock) FetchMarketingPermissionIDs(id string) (map[string]string, error) {
+	client.FetchMarketingPermissionIDsCalls++
+	return client.FetchMarketingPermissionIDsMock(id)
+}
+
+func (client *ClientMock) SearchMembers(query, listID string) (MemberSearchResults, error) {
+	client.SearchMembersCalls++
+	return client.SearchMembersMock(query, listID)
+}
+
+func (client *ClientMock) FetchMember(listID, email string) (Member, error) {
+	client.FetchMemberCalls++
+	return client.FetchMemberMock(listID, email)
+}
+
 func (client *ClientMock) UpdateMember(listID, email string, member Member) error {
 	client.UpdateMemberCalls++
 	return client.UpdateMemberMock(listID, email, member)
@@ -141,11 +394,331 @@ func (client *ClientMock) UpdateMemberTagsSync(id, memberEmail string, tags []Ta
 	return client.UpdateMemberTagsSyncMock(id, memberEmail, tags)
 }
 
+func (client *ClientMock) FetchMembersByTag(id, tagName string) *MemberIterator {
+	client.FetchMembersByTagCalls++
+	return client.FetchMembersByTagMock(id, tagName)
+}
+
+func (client *ClientMock) SetMemberTags(id, memberEmail string, desired []string) error {
+	client.SetMemberTagsCalls++
+	return client.SetMemberTagsMock(id, memberEmail, desired)
+}
+
+func (client *ClientMock) SetMemberTagsWithPrefix(id, memberEmail, prefix string, desired []string) error {
+	client.SetMemberTagsWithPrefixCalls++
+	return client.SetMemberTagsWithPrefixMock(id, memberEmail, prefix, desired)
+}
+
+func (client *ClientMock) SearchListTags(id, prefix string) ([]ListTag, error) {
+	client.SearchListTagsCalls++
+	return client.SearchListTagsMock(id, prefix)
+}
+
+func (client *ClientMock) TagMembers(id, tagName string, emails []string, active bool) ([]TagMemberResult, error) {
+	client.TagMembersCalls++
+	return client.TagMembersMock(id, tagName, emails, active)
+}
+
+func (client *ClientMock) CreateMemberNote(id, memberEmail, note string) (Note, error) {
+	client.CreateMemberNoteCalls++
+	return client.CreateMemberNoteMock(id, memberEmail, note)
+}
+
+func (client *ClientMock) FetchMemberNotes(id, memberEmail string) ([]Note, error) {
+	client.FetchMemberNotesCalls++
+	return client.FetchMemberNotesMock(id, memberEmail)
+}
+
+func (client *ClientMock) UpdateMemberNote(id, memberEmail string, noteID int, note string) (Note, error) {
+	client.UpdateMemberNoteCalls++
+	return client.UpdateMemberNoteMock(id, memberEmail, noteID, note)
+}
+
+func (client *ClientMock) DeleteMemberNote(id, memberEmail string, noteID int) error {
+	client.DeleteMemberNoteCalls++
+	return client.DeleteMemberNoteMock(id, memberEmail, noteID)
+}
+
+func (client *ClientMock) CreateMemberEvent(id, memberEmail string, event Event) error {
+	client.CreateMemberEventCalls++
+	return client.CreateMemberEventMock(id, memberEmail, event)
+}
+
+func (client *ClientMock) FetchMemberEvents(id, memberEmail string) ([]Event, error) {
+	client.FetchMemberEventsCalls++
+	return client.FetchMemberEventsMock(id, memberEmail)
+}
+
+func (client *ClientMock) FetchMemberActivity(id, memberEmail string, filters MemberActivityFilters) (MemberActivityFeed, error) {
+	client.FetchMemberActivityCalls++
+	return client.FetchMemberActivityMock(id, memberEmail, filters)
+}
+
 func (client *ClientMock) ArchiveMember(id, memberEmail string) error {
 	client.ArchiveMemberCalls++
 	return client.ArchiveMemberMock(id, memberEmail)
 }
 
+func (client *ClientMock) CreateCampaign(campaign Campaign) (Campaign, error) {
+	client.CreateCampaignCalls++
+	return client.CreateCampaignMock(campaign)
+}
+
+func (client *ClientMock) FetchCampaigns(filters CampaignFilters) ([]Campaign, error) {
+	client.FetchCampaignsCalls++
+	return client.FetchCampaignsMock(filters)
+}
+
+func (client *ClientMock) FetchCampaign(id string) (Campaign, error) {
+	client.FetchCampaignCalls++
+	return client.FetchCampaignMock(id)
+}
+
+func (client *ClientMock) UpdateCampaign(id string, campaign Campaign) (Campaign, error) {
+	client.UpdateCampaignCalls++
+	return client.UpdateCampaignMock(id, campaign)
+}
+
+func (client *ClientMock) DeleteCampaign(id string) error {
+	client.DeleteCampaignCalls++
+	return client.DeleteCampaignMock(id)
+}
+
+func (client *ClientMock) CreateCampaignFolder(name string) (CampaignFolder, error) {
+	client.CreateCampaignFolderCalls++
+	return client.CreateCampaignFolderMock(name)
+}
+
+func (client *ClientMock) FetchCampaignFolders(page Page) ([]CampaignFolder, error) {
+	client.FetchCampaignFoldersCalls++
+	return client.FetchCampaignFoldersMock(page)
+}
+
+func (client *ClientMock) FetchCampaignFolder(id string) (CampaignFolder, error) {
+	client.FetchCampaignFolderCalls++
+	return client.FetchCampaignFolderMock(id)
+}
+
+func (client *ClientMock) UpdateCampaignFolder(id, name string) (CampaignFolder, error) {
+	client.UpdateCampaignFolderCalls++
+	return client.UpdateCampaignFolderMock(id, name)
+}
+
+func (client *ClientMock) DeleteCampaignFolder(id string) error {
+	client.DeleteCampaignFolderCalls++
+	return client.DeleteCampaignFolderMock(id)
+}
+
+func (client *ClientMock) SetCampaignContent(id string, content CampaignContent) (RenderedCampaignContent, error) {
+	client.SetCampaignContentCalls++
+	return client.SetCampaignContentMock(id, content)
+}
+
+func (client *ClientMock) FetchCampaignContent(id string) (RenderedCampaignContent, error) {
+	client.FetchCampaignContentCalls++
+	return client.FetchCampaignContentMock(id)
+}
+
+func (client *ClientMock) SendCampaign(id string) error {
+	client.SendCampaignCalls++
+	return client.SendCampaignMock(id)
+}
+
+func (client *ClientMock) SendCampaignWithChecklist(id string) error {
+	client.SendCampaignWithChecklistCalls++
+	return client.SendCampaignWithChecklistMock(id)
+}
+
+func (client *ClientMock) FetchSendChecklist(id string) (SendChecklist, error) {
+	client.FetchSendChecklistCalls++
+	return client.FetchSendChecklistMock(id)
+}
+
+func (client *ClientMock) SendTestEmail(id string, emails []string, sendType string) error {
+	client.SendTestEmailCalls++
+	return client.SendTestEmailMock(id, emails, sendType)
+}
+
+func (client *ClientMock) ScheduleCampaign(id string, scheduleTime time.Time, timewarp bool, batchDelivery *BatchDelivery) error {
+	client.ScheduleCampaignCalls++
+	return client.ScheduleCampaignMock(id, scheduleTime, timewarp, batchDelivery)
+}
+
+func (client *ClientMock) UnscheduleCampaign(id string) error {
+	client.UnscheduleCampaignCalls++
+	return client.UnscheduleCampaignMock(id)
+}
+
+func (client *ClientMock) PauseCampaign(id string) error {
+	client.PauseCampaignCalls++
+	return client.PauseCampaignMock(id)
+}
+
+func (client *ClientMock) ResumeCampaign(id string) error {
+	client.ResumeCampaignCalls++
+	return client.ResumeCampaignMock(id)
+}
+
+func (client *ClientMock) ReplicateCampaign(id string) (Campaign, error) {
+	client.ReplicateCampaignCalls++
+	return client.ReplicateCampaignMock(id)
+}
+
+func (client *ClientMock) CancelSend(id string) error {
+	client.CancelSendCalls++
+	return client.CancelSendMock(id)
+}
+
+func (client *ClientMock) FetchCampaignReport(id string) (CampaignReport, error) {
+	client.FetchCampaignReportCalls++
+	return client.FetchCampaignReportMock(id)
+}
+
+func (client *ClientMock) FetchReportClickDetails(id string, page Page) (ClickDetailCollection, error) {
+	client.FetchReportClickDetailsCalls++
+	return client.FetchReportClickDetailsMock(id, page)
+}
+
+func (client *ClientMock) FetchReportOpenDetails(id string, page Page) (OpenDetailCollection, error) {
+	client.FetchReportOpenDetailsCalls++
+	return client.FetchReportOpenDetailsMock(id, page)
+}
+
+func (client *ClientMock) FetchReportEmailActivity(id string, page Page) (EmailActivityCollection, error) {
+	client.FetchReportEmailActivityCalls++
+	return client.FetchReportEmailActivityMock(id, page)
+}
+
+func (client *ClientMock) StreamEmailActivity(id string, since time.Time) *EmailActivityIterator {
+	client.StreamEmailActivityCalls++
+	return client.StreamEmailActivityMock(id, since)
+}
+
+func (client *ClientMock) FetchReportUnsubscribes(id string, page Page) (UnsubscribeCollection, error) {
+	client.FetchReportUnsubscribesCalls++
+	return client.FetchReportUnsubscribesMock(id, page)
+}
+
+func (client *ClientMock) FetchReportSentTo(id string, page Page) (SentToCollection, error) {
+	client.FetchReportSentToCalls++
+	return client.FetchReportSentToMock(id, page)
+}
+
+func (client *ClientMock) FetchReportDomainPerformance(id string) ([]DomainPerformance, error) {
+	client.FetchReportDomainPerformanceCalls++
+	return client.FetchReportDomainPerformanceMock(id)
+}
+
+func (client *ClientMock) FetchReportLocations(id string, page Page) (OpenLocationCollection, error) {
+	client.FetchReportLocationsCalls++
+	return client.FetchReportLocationsMock(id, page)
+}
+
+func (client *ClientMock) CreateTemplate(template Template) (Template, error) {
+	client.CreateTemplateCalls++
+	return client.CreateTemplateMock(template)
+}
+
+func (client *ClientMock) FetchTemplates(filters TemplateFilters) ([]Template, error) {
+	client.FetchTemplatesCalls++
+	return client.FetchTemplatesMock(filters)
+}
+
+func (client *ClientMock) FetchTemplate(id int) (Template, error) {
+	client.FetchTemplateCalls++
+	return client.FetchTemplateMock(id)
+}
+
+func (client *ClientMock) UpdateTemplate(id int, template Template) (Template, error) {
+	client.UpdateTemplateCalls++
+	return client.UpdateTemplateMock(id, template)
+}
+
+func (client *ClientMock) DeleteTemplate(id int) error {
+	client.DeleteTemplateCalls++
+	return client.DeleteTemplateMock(id)
+}
+
+func (client *ClientMock) FetchTemplateDefaultContent(id int) (TemplateDefaultContent, error) {
+	client.FetchTemplateDefaultContentCalls++
+	return client.FetchTemplateDefaultContentMock(id)
+}
+
+func (client *ClientMock) SyncTemplate(template Template) (Template, error) {
+	client.SyncTemplateCalls++
+	return client.SyncTemplateMock(template)
+}
+
+func (client *ClientMock) SyncTemplateFromFile(name, path string) (Template, error) {
+	client.SyncTemplateFromFileCalls++
+	return client.SyncTemplateFromFileMock(name, path)
+}
+
+func (client *ClientMock) CreateTemplateFolder(name string) (TemplateFolder, error) {
+	client.CreateTemplateFolderCalls++
+	return client.CreateTemplateFolderMock(name)
+}
+
+func (client *ClientMock) FetchTemplateFolders(page Page) ([]TemplateFolder, error) {
+	client.FetchTemplateFoldersCalls++
+	return client.FetchTemplateFoldersMock(page)
+}
+
+func (client *ClientMock) FetchTemplateFolder(id string) (TemplateFolder, error) {
+	client.FetchTemplateFolderCalls++
+	return client.FetchTemplateFolderMock(id)
+}
+
+func (client *ClientMock) UpdateTemplateFolder(id, name string) (TemplateFolder, error) {
+	client.UpdateTemplateFolderCalls++
+	return client.UpdateTemplateFolderMock(id, name)
+}
+
+func (client *ClientMock) DeleteTemplateFolder(id string) error {
+	client.DeleteTemplateFolderCalls++
+	return client.DeleteTemplateFolderMock(id)
+}
+
+func (client *ClientMock) FetchAutomations(page Page) ([]Automation, error) {
+	client.FetchAutomationsCalls++
+	return client.FetchAutomationsMock(page)
+}
+
+func (client *ClientMock) FetchAutomation(id string) (Automation, error) {
+	client.FetchAutomationCalls++
+	return client.FetchAutomationMock(id)
+}
+
+func (client *ClientMock) StartAutomation(id string) error {
+	client.StartAutomationCalls++
+	return client.StartAutomationMock(id)
+}
+
+func (client *ClientMock) PauseAutomation(id string) error {
+	client.PauseAutomationCalls++
+	return client.PauseAutomationMock(id)
+}
+
+func (client *ClientMock) FetchAutomationEmails(id string) ([]AutomationEmail, error) {
+	client.FetchAutomationEmailsCalls++
+	return client.FetchAutomationEmailsMock(id)
+}
+
+func (client *ClientMock) AddSubscriberToAutomationQueue(workflowID, workflowEmailID, memberEmail string) error {
+	client.AddSubscriberToAutomationQueueCalls++
+	return client.AddSubscriberToAutomationQueueMock(workflowID, workflowEmailID, memberEmail)
+}
+
+func (client *ClientMock) RemoveSubscriberFromAutomation(workflowID, memberEmail string) error {
+	client.RemoveSubscriberFromAutomationCalls++
+	return client.RemoveSubscriberFromAutomationMock(workflowID, memberEmail)
+}
+
+func (client *ClientMock) TriggerJourneyStep(journeyID, stepID int, memberEmail string) error {
+	client.TriggerJourneyStepCalls++
+	return client.TriggerJourneyStepMock(journeyID, stepID, memberEmail)
+}
+
 func (mock *ClientMock) CreateWebhook(webhook Webhook) (Webhook, error) {
 	mock.CreateWebhookCalls++
 	return mock.CreateWebhookMock(webhook)
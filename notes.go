@@ -0,0 +1,24 @@
+package mailchimp
+
+import "time"
+
+var NullNote = Note{}
+
+type Note struct {
+	ID        int       `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Note      string    `json:"note"`
+	ListID    string    `json:"list_id"`
+	EmailID   string    `json:"email_id"`
+}
+
+type noteCollection struct {
+	Notes      []Note `json:"notes"`
+	TotalItems int    `json:"total_items"`
+}
+
+type notePayload struct {
+	Note string `json:"note"`
+}
@@ -0,0 +1,57 @@
+package mailchimp
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// Page selects a window of a paged MailChimp collection. A zero Count
+// leaves the page size up to MailChimp, which defaults to 10 items.
+type Page struct {
+	Count  int
+	Offset int
+}
+
+func (p Page) addTo(query url.Values) {
+	if p.Count > 0 {
+		query.Set("count", strconv.Itoa(p.Count))
+	}
+	if p.Offset > 0 {
+		query.Set("offset", strconv.Itoa(p.Offset))
+	}
+}
+
+// pager walks through a paged MailChimp collection one page at a time,
+// and is shared by the iterators of the package. fetch requests the
+// given page and returns the number of items on it together with the
+// total number of items in the collection, leaving the items
+// themselves to the iterator.
+type pager struct {
+	size    int
+	fetch   func(page Page) (int, int, error)
+	offset  int
+	total   int
+	fetched bool
+	err     error
+}
+
+// more fetches the next page, and returns false once the collection
+// has been exhausted or a page could not be fetched.
+func (p *pager) more() bool {
+	if p.err != nil || p.fetch == nil || (p.fetched && p.offset >= p.total) {
+		return false
+	}
+	count, total, err := p.fetch(Page{Count: p.size, Offset: p.offset})
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.fetched = true
+	p.total = total
+	p.offset += count
+	if count == 0 {
+		p.total = p.offset
+		return false
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package mailchimp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPager_PagesThroughCollection(t *testing.T) {
+	pages := make([]Page, 0)
+	p := pager{
+		size: 2,
+		fetch: func(page Page) (int, int, error) {
+			pages = append(pages, page)
+			if page.Offset == 0 {
+				return 2, 3, nil
+			}
+			return 1, 3, nil
+		},
+	}
+	fetched := 0
+	for p.more() {
+		fetched++
+	}
+	if p.err != nil {
+		t.Errorf("expected no error to be returned, but got '%s'", p.err.Error())
+	}
+	if fetched != 2 {
+		t.Errorf("expected 2 pages to be fetched but was %d", fetched)
+	}
+	if len(pages) != 2 || pages[0] != (Page{Count: 2}) || pages[1] != (Page{Count: 2, Offset: 2}) {
+		t.Errorf("expected pages at offsets [0 2] but fetched %v", pages)
+	}
+}
+
+func TestPager_StopsOnEmptyPage(t *testing.T) {
+	calls := 0
+	p := pager{
+		size: 2,
+		fetch: func(page Page) (int, int, error) {
+			calls++
+			return 0, 10, nil
+		},
+	}
+	if p.more() || p.more() {
+		t.Error("expected more to return false but returned true")
+	}
+	if calls != 1 {
+		t.Errorf("expected a single fetch but was %d", calls)
+	}
+}
+
+func TestPager_StopsOnError(t *testing.T) {
+	p := pager{
+		size: 2,
+		fetch: func(page Page) (int, int, error) {
+			return 0, 0, errors.New("mocked error")
+		},
+	}
+	if p.more() {
+		t.Error("expected more to return false but returned true")
+	}
+	if p.err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
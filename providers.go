@@ -15,18 +15,36 @@ const (
 	ResponseStatusFailedServer = 5 // for 500
 )
 
-type errorResponse struct {
-	Type     string `json:"type"`
-	Title    string `json:"title"`
-	Status   int    `json:"status"`
-	Detail   string `json:"detail"`
-	Instance string `json:"instance"`
+// APIError is the error returned when MailChimp responds to a request
+// with an error. Errors lists the fields that caused the error, if
+// MailChimp reported any.
+type APIError struct {
+	Type     string          `json:"type"`
+	Title    string          `json:"title"`
+	Status   int             `json:"status"`
+	Detail   string          `json:"detail"`
+	Instance string          `json:"instance"`
+	Errors   []APIFieldError `json:"errors"`
+}
+
+type APIFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf(
+		"request was not successful: %s Status %d",
+		e.Detail,
+		e.Status,
+	)
 }
 
 type MailChimpProvider interface {
 	Post(uri string, body interface{}) ([]byte, error)
 	Get(uri string) ([]byte, error)
 	Patch(uri string, body interface{}) ([]byte, error)
+	Put(uri string, body interface{}) ([]byte, error)
 	Delete(uri string) ([]byte, error)
 }
 
@@ -101,6 +119,28 @@ func (mcp mailChimpProvider) Patch(uri string, body interface{}) ([]byte, error)
 	return bytes, nil
 }
 
+func (mcp mailChimpProvider) Put(uri string, body interface{}) ([]byte, error) {
+	httpClient := http.DefaultClient
+	req, err := mcp.createBodyRequest("PUT", uri, body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	status := resp.StatusCode / 100 // Get the first digit of the status code
+	bytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if status != ResponseStatusSuccess {
+		return nil, mcp.handleFailedRequest(bytes)
+	}
+	return bytes, nil
+}
+
 func (mcp mailChimpProvider) Delete(uri string) ([]byte, error) {
 	httpClient := http.DefaultClient
 	req, err := mcp.createBodylessRequest("DELETE", uri)
@@ -154,18 +194,14 @@ func (mcp mailChimpProvider) createBodyRequest(method, uri string, body interfac
 }
 
 func (mcp mailChimpProvider) handleFailedRequest(body []byte) error {
-	errResponse := errorResponse{}
-	err := json.Unmarshal(body, &errResponse)
+	errResponse := &APIError{}
+	err := json.Unmarshal(body, errResponse)
 	if err != nil {
 		return errors.New(
 			"request was not successful, and could not unmarshal error response",
 		)
 	}
-	return fmt.Errorf(
-		"request was not successful: %s Status %d",
-		errResponse.Detail,
-		errResponse.Status,
-	)
+	return errResponse
 }
 
 func (mcp mailChimpProvider) url(uri string) string {
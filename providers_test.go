@@ -0,0 +1,30 @@
+package mailchimp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMailChimpProvider_HandleFailedRequestReturnsAPIError(t *testing.T) {
+	body := []byte(`{"title":"Bad Request","status":400,"detail":"Your Campaign is not ready to send.","errors":[{"field":"","message":"No content"}]}`)
+	err := mailChimpProvider{}.handleFailedRequest(body)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected error to be an APIError, but was %T", err)
+	}
+	if apiErr.Status != 400 || len(apiErr.Errors) != 1 {
+		t.Errorf("expected status 400 with one field error, but got %+v", apiErr)
+	}
+	expected := "request was not successful: Your Campaign is not ready to send. Status 400"
+	if err.Error() != expected {
+		t.Errorf("expected error message to be '%s', but was '%s'", expected, err.Error())
+	}
+}
+
+func TestMailChimpProvider_HandleFailedRequestWithInvalidBody(t *testing.T) {
+	err := mailChimpProvider{}.handleFailedRequest([]byte("not json"))
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		t.Error("expected error to not be an APIError")
+	}
+}
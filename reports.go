@@ -0,0 +1,347 @@
+package mailchimp
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+var NullCampaignReport = CampaignReport{}
+
+// CampaignReport holds the summary statistics of a sent campaign.
+type CampaignReport struct {
+	ID            string              `json:"id"`
+	CampaignTitle string              `json:"campaign_title"`
+	Type          string              `json:"type"`
+	ListID        string              `json:"list_id"`
+	ListName      string              `json:"list_name"`
+	SubjectLine   string              `json:"subject_line"`
+	EmailsSent    int                 `json:"emails_sent"`
+	AbuseReports  int                 `json:"abuse_reports"`
+	Unsubscribed  int                 `json:"unsubscribed"`
+	SendTime      string              `json:"send_time"`
+	Bounces       ReportBounces       `json:"bounces"`
+	Forwards      ReportForwards      `json:"forwards"`
+	Opens         ReportOpens         `json:"opens"`
+	Clicks        ReportClicks        `json:"clicks"`
+	IndustryStats ReportIndustryStats `json:"industry_stats"`
+	ListStats     ReportListStats     `json:"list_stats"`
+}
+
+type ReportBounces struct {
+	HardBounces  int `json:"hard_bounces"`
+	SoftBounces  int `json:"soft_bounces"`
+	SyntaxErrors int `json:"syntax_errors"`
+}
+
+type ReportForwards struct {
+	ForwardsCount int `json:"forwards_count"`
+	ForwardsOpens int `json:"forwards_opens"`
+}
+
+type ReportOpens struct {
+	OpensTotal  int     `json:"opens_total"`
+	UniqueOpens int     `json:"unique_opens"`
+	OpenRate    float64 `json:"open_rate"`
+	LastOpen    string  `json:"last_open"` // empty if never opened
+}
+
+type ReportClicks struct {
+	ClicksTotal            int     `json:"clicks_total"`
+	UniqueClicks           int     `json:"unique_clicks"`
+	UniqueSubscriberClicks int     `json:"unique_subscriber_clicks"`
+	ClickRate              float64 `json:"click_rate"`
+	LastClick              string  `json:"last_click"` // empty if never clicked
+}
+
+// ReportIndustryStats holds the average rates of the industry set on
+// the MailChimp account, for comparing the campaign against.
+type ReportIndustryStats struct {
+	Type       string  `json:"type"`
+	OpenRate   float64 `json:"open_rate"`
+	ClickRate  float64 `json:"click_rate"`
+	BounceRate float64 `json:"bounce_rate"`
+	UnopenRate float64 `json:"unopen_rate"`
+	UnsubRate  float64 `json:"unsub_rate"`
+	AbuseRate  float64 `json:"abuse_rate"`
+}
+
+// ReportListStats holds the average rates of the list the campaign
+// was sent to.
+type ReportListStats struct {
+	SubRate   float64 `json:"sub_rate"`
+	UnsubRate float64 `json:"unsub_rate"`
+	OpenRate  float64 `json:"open_rate"`
+	ClickRate float64 `json:"click_rate"`
+}
+
+// ClickDetail holds the clicks on a single link in a campaign.
+type ClickDetail struct {
+	ID                    string  `json:"id"`
+	URL                   string  `json:"url"`
+	TotalClicks           int     `json:"total_clicks"`
+	ClickPercentage       float64 `json:"click_percentage"`
+	UniqueClicks          int     `json:"unique_clicks"`
+	UniqueClickPercentage float64 `json:"unique_click_percentage"`
+	LastClick             string  `json:"last_click"` // empty if never clicked
+	CampaignID            string  `json:"campaign_id"`
+}
+
+// ClickDetailCollection is a page of click details. TotalItems is the
+// number of links in the campaign across all pages.
+type ClickDetailCollection struct {
+	URLsClicked []ClickDetail `json:"urls_clicked"`
+	TotalItems  int           `json:"total_items"`
+}
+
+// OpenDetail holds the opens of a single member who opened a
+// campaign.
+type OpenDetail struct {
+	CampaignID   string      `json:"campaign_id"`
+	ListID       string      `json:"list_id"`
+	EmailID      string      `json:"email_id"`
+	EmailAddress string      `json:"email_address"`
+	MergeFields  MergeFields `json:"merge_fields"`
+	VIP          bool        `json:"vip"`
+	OpensCount   int         `json:"opens_count"`
+	Opens        []Open      `json:"opens"`
+}
+
+type Open struct {
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// OpenDetailCollection is a page of the members who opened a campaign.
+// TotalOpens is the number of opens and TotalItems the number of
+// members across all pages.
+type OpenDetailCollection struct {
+	Members    []OpenDetail `json:"members"`
+	TotalOpens int          `json:"total_opens"`
+	TotalItems int          `json:"total_items"`
+}
+
+// EmailActivity holds every action a single member has taken on a
+// campaign.
+type EmailActivity struct {
+	CampaignID   string                `json:"campaign_id"`
+	ListID       string                `json:"list_id"`
+	EmailID      string                `json:"email_id"`
+	EmailAddress string                `json:"email_address"`
+	Activity     []EmailActivityAction `json:"activity"`
+}
+
+// EmailActivityAction is an open, click or bounce. Type is set only
+// for bounces and URL only for clicks.
+type EmailActivityAction struct {
+	Action    string    `json:"action"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	URL       string    `json:"url"`
+	IP        string    `json:"ip"`
+}
+
+// EmailActivityCollection is a page of email activity. TotalItems is
+// the number of members across all pages.
+type EmailActivityCollection struct {
+	Emails     []EmailActivity `json:"emails"`
+	TotalItems int             `json:"total_items"`
+}
+
+// Unsubscribe is a member who unsubscribed from the list through a
+// campaign.
+type Unsubscribe struct {
+	CampaignID   string      `json:"campaign_id"`
+	ListID       string      `json:"list_id"`
+	EmailID      string      `json:"email_id"`
+	EmailAddress string      `json:"email_address"`
+	MergeFields  MergeFields `json:"merge_fields"`
+	VIP          bool        `json:"vip"`
+	Timestamp    time.Time   `json:"timestamp"`
+	Reason       string      `json:"reason"`
+}
+
+// UnsubscribeCollection is a page of unsubscribes. TotalItems is the
+// number of unsubscribes across all pages.
+type UnsubscribeCollection struct {
+	Unsubscribes []Unsubscribe `json:"unsubscribes"`
+	TotalItems   int           `json:"total_items"`
+}
+
+// SentTo is a member the campaign was sent to. Status is one of
+// "sent", "hard" or "soft".
+type SentTo struct {
+	CampaignID   string      `json:"campaign_id"`
+	ListID       string      `json:"list_id"`
+	EmailID      string      `json:"email_id"`
+	EmailAddress string      `json:"email_address"`
+	MergeFields  MergeFields `json:"merge_fields"`
+	VIP          bool        `json:"vip"`
+	Status       string      `json:"status"`
+	OpenCount    int         `json:"open_count"`
+	LastOpen     string      `json:"last_open"` // empty if never opened
+	AbsplitGroup string      `json:"absplit_group"`
+	GMTOffset    int         `json:"gmt_offset"`
+}
+
+// SentToCollection is a page of the members the campaign was sent to.
+// TotalItems is the number of members across all pages.
+type SentToCollection struct {
+	SentTo     []SentTo `json:"sent_to"`
+	TotalItems int      `json:"total_items"`
+}
+
+// DomainPerformance holds the statistics of the campaign for a single
+// email domain, such as gmail.com.
+type DomainPerformance struct {
+	Domain     string  `json:"domain"`
+	EmailsSent int     `json:"emails_sent"`
+	Bounces    int     `json:"bounces"`
+	Opens      int     `json:"opens"`
+	Clicks     int     `json:"clicks"`
+	Unsubs     int     `json:"unsubs"`
+	Delivered  int     `json:"delivered"`
+	EmailsPct  float64 `json:"emails_pct"`
+	BouncesPct float64 `json:"bounces_pct"`
+	OpensPct   float64 `json:"opens_pct"`
+	ClicksPct  float64 `json:"clicks_pct"`
+	UnsubsPct  float64 `json:"unsubs_pct"`
+}
+
+type domainPerformanceCollection struct {
+	Domains    []DomainPerformance `json:"domains"`
+	TotalItems int                 `json:"total_items"`
+}
+
+// OpenLocation holds the number of opens of the campaign from a
+// single region.
+type OpenLocation struct {
+	CountryCode string `json:"country_code"`
+	Region      string `json:"region"`
+	RegionName  string `json:"region_name"`
+	Opens       int    `json:"opens"`
+}
+
+// OpenLocationCollection is a page of open locations. TotalItems is
+// the number of locations across all pages.
+type OpenLocationCollection struct {
+	Locations  []OpenLocation `json:"locations"`
+	TotalItems int            `json:"total_items"`
+}
+
+// emailActivityIteratorPageSize is the number of members fetched per
+// request by an EmailActivityIterator, which is the largest page
+// MailChimp allows.
+const emailActivityIteratorPageSize = 1000
+
+// EmailActivityIterator pages through the email activity of a
+// campaign, keeping only a single page in memory at a time. Call Next
+// to advance the iterator and EmailActivity to get the activity of the
+// current member. Once Next returns false, Err reports whether the
+// iteration stopped because of an error.
+type EmailActivityIterator struct {
+	pager   pager
+	page    []EmailActivity
+	current EmailActivity
+}
+
+func newEmailActivityIterator(fetch func(page Page) ([]EmailActivity, int, error)) *EmailActivityIterator {
+	it := &EmailActivityIterator{}
+	it.pager = pager{
+		size: emailActivityIteratorPageSize,
+		fetch: func(page Page) (int, int, error) {
+			emails, total, err := fetch(page)
+			it.page = emails
+			return len(emails), total, err
+		},
+	}
+	return it
+}
+
+func (it *EmailActivityIterator) Next() bool {
+	if len(it.page) == 0 && !it.pager.more() {
+		return false
+	}
+	it.current = it.page[0]
+	it.page[0] = EmailActivity{}
+	it.page = it.page[1:]
+	return true
+}
+
+func (it *EmailActivityIterator) EmailActivity() EmailActivity {
+	return it.current
+}
+
+func (it *EmailActivityIterator) Err() error {
+	return it.pager.err
+}
+
+var emailActivityCSVHeader = []string{
+	"email_address",
+	"email_id",
+	"campaign_id",
+	"list_id",
+	"action",
+	"type",
+	"timestamp",
+	"url",
+	"ip",
+}
+
+// WriteEmailActivityCSV writes every action of the iterator to w as
+// CSV, with a header row followed by one row per action. Members
+// without any activity are not written. The number of rows written,
+// excluding the header, is returned along with any error from the
+// iterator or the writer.
+func WriteEmailActivityCSV(w io.Writer, it *EmailActivityIterator) (int, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(emailActivityCSVHeader); err != nil {
+		return 0, err
+	}
+	rows := 0
+	for it.Next() {
+		email := it.EmailActivity()
+		for _, action := range email.Activity {
+			err := writer.Write([]string{
+				email.EmailAddress,
+				email.EmailID,
+				email.CampaignID,
+				email.ListID,
+				action.Action,
+				action.Type,
+				action.Timestamp.Format(time.RFC3339),
+				action.URL,
+				action.IP,
+			})
+			if err != nil {
+				return rows, err
+			}
+			rows++
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return rows, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return rows, err
+	}
+	return rows, it.Err()
+}
+
+// WriteEmailActivityJSONLines writes the activity of every member of
+// the iterator to w as JSON Lines, with one EmailActivity per line.
+// The number of lines written is returned along with any error from
+// the iterator or the writer.
+func WriteEmailActivityJSONLines(w io.Writer, it *EmailActivityIterator) (int, error) {
+	encoder := json.NewEncoder(w)
+	lines := 0
+	for it.Next() {
+		if err := encoder.Encode(it.EmailActivity()); err != nil {
+			return lines, err
+		}
+		lines++
+	}
+	return lines, it.Err()
+}
@@ -0,0 +1,78 @@
+package mailchimp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testEmailActivity() []EmailActivity {
+	opened := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	return []EmailActivity{
+		{
+			EmailAddress: "a@test.com",
+			EmailID:      "a1",
+			Activity: []EmailActivityAction{
+				{Action: "open", Timestamp: opened},
+				{Action: "click", Timestamp: opened, URL: "https://example.com"},
+			},
+		},
+		{EmailAddress: "b@test.com", EmailID: "b1"},
+	}
+}
+
+func TestWriteEmailActivityCSV(t *testing.T) {
+	buf := bytes.Buffer{}
+	rows, err := WriteEmailActivityCSV(&buf, NewEmailActivityIteratorMock(testEmailActivity(), nil))
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if rows != 2 {
+		t.Errorf("expected 2 rows to be written but was %d", rows)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and 2 rows but got %v", lines)
+	}
+	if lines[0] != strings.Join(emailActivityCSVHeader, ",") {
+		t.Errorf("expected header row but got '%s'", lines[0])
+	}
+	expected := "a@test.com,a1,,,click,,2021-03-01T12:00:00Z,https://example.com,"
+	if lines[2] != expected {
+		t.Errorf("expected row to be '%s' but was '%s'", expected, lines[2])
+	}
+}
+
+func TestWriteEmailActivityCSVReturnsIteratorError(t *testing.T) {
+	buf := bytes.Buffer{}
+	_, err := WriteEmailActivityCSV(&buf, NewEmailActivityIteratorMock(nil, errors.New("mocked error")))
+	if err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestWriteEmailActivityJSONLines(t *testing.T) {
+	buf := bytes.Buffer{}
+	lines, err := WriteEmailActivityJSONLines(&buf, NewEmailActivityIteratorMock(testEmailActivity(), nil))
+	if err != nil {
+		t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+	}
+	if lines != 2 {
+		t.Errorf("expected 2 lines to be written but was %d", lines)
+	}
+	decoder := json.NewDecoder(&buf)
+	decoded := make([]EmailActivity, 0)
+	for decoder.More() {
+		email := EmailActivity{}
+		if err := decoder.Decode(&email); err != nil {
+			t.Fatalf("expected no error to be returned, but got '%s'", err.Error())
+		}
+		decoded = append(decoded, email)
+	}
+	if len(decoded) != 2 || len(decoded[0].Activity) != 2 || decoded[1].EmailAddress != "b@test.com" {
+		t.Errorf("expected activity to round trip, but got %+v", decoded)
+	}
+}
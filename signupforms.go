@@ -0,0 +1,63 @@
+package mailchimp
+
+var NullSignupForm = SignupForm{}
+
+// SignupForm is the hosted signup form of a list. Only Header, Contents
+// and Styles are sent to MailChimp when customizing a form.
+type SignupForm struct {
+	Header        SignupFormHeader    `json:"header"`
+	Contents      []SignupFormContent `json:"contents"`
+	Styles        []SignupFormStyle   `json:"styles"`
+	SignupFormURL string              `json:"signup_form_url"`
+	ListID        string              `json:"list_id"`
+}
+
+type SignupFormHeader struct {
+	ImageURL         string `json:"image_url,omitempty"`
+	Text             string `json:"text,omitempty"`
+	ImageWidth       string `json:"image_width,omitempty"`
+	ImageHeight      string `json:"image_height,omitempty"`
+	ImageAlt         string `json:"image_alt,omitempty"`
+	ImageLink        string `json:"image_link,omitempty"`
+	ImageAlign       string `json:"image_align,omitempty"`
+	ImageBorderWidth string `json:"image_border_width,omitempty"`
+	ImageBorderStyle string `json:"image_border_style,omitempty"`
+	ImageBorderColor string `json:"image_border_color,omitempty"`
+	ImageTarget      string `json:"image_target,omitempty"`
+}
+
+const (
+	SignupFormSectionMessage       = "signup_message"
+	SignupFormSectionUnsubMessage  = "unsub_message"
+	SignupFormSectionThankYouTitle = "signup_thank_you_title"
+)
+
+// SignupFormContent is the text of a section of the form, such as
+// SignupFormSectionMessage.
+type SignupFormContent struct {
+	Section string `json:"section"`
+	Value   string `json:"value"`
+}
+
+// SignupFormStyle sets CSS properties on an element of the form, such
+// as "page_background" or "form_header_text".
+type SignupFormStyle struct {
+	Selector string                  `json:"selector"`
+	Options  []SignupFormStyleOption `json:"options"`
+}
+
+type SignupFormStyleOption struct {
+	Property string `json:"property"`
+	Value    string `json:"value"`
+}
+
+type signupFormCollection struct {
+	SignupForms []SignupForm `json:"signup_forms"`
+	TotalItems  int          `json:"total_items"`
+}
+
+type signupFormPayload struct {
+	Header   SignupFormHeader    `json:"header"`
+	Contents []SignupFormContent `json:"contents,omitempty"`
+	Styles   []SignupFormStyle   `json:"styles,omitempty"`
+}
@@ -0,0 +1,92 @@
+package mailchimp
+
+import "fmt"
+
+// StatusAction describes what MailChimp will do when the status of a
+// member is changed, as returned by MemberStatusAction.
+type StatusAction string
+
+const (
+	// StatusActionNone means that the status does not change.
+	StatusActionNone StatusAction = "none"
+	// StatusActionSubscribe subscribes the member directly.
+	StatusActionSubscribe StatusAction = "subscribe"
+	// StatusActionUnsubscribe unsubscribes the member.
+	StatusActionUnsubscribe StatusAction = "unsubscribe"
+	// StatusActionConfirm sends an opt-in confirmation email to the
+	// member, who stays pending until they have confirmed.
+	StatusActionConfirm StatusAction = "confirm"
+	// StatusActionTransactional adds the member for transactional
+	// emails only, without subscribing them to marketing emails.
+	StatusActionTransactional StatusAction = "transactional"
+)
+
+// MemberStatusAction returns the action MailChimp takes when a member
+// goes from the current to the desired status, or an error describing
+// why MailChimp would reject the change. An empty current status means
+// that the member is new to the list, and an empty desired status means
+// that the status is left as it is. Archived members are re-added to
+// the list like new members.
+func MemberStatusAction(current, desired MemberStatus) (StatusAction, error) {
+	if desired == "" || desired == current {
+		return StatusActionNone, nil
+	}
+	if current != "" && !current.known() {
+		return StatusActionNone, fmt.Errorf("unknown current member status '%s'", current)
+	}
+	if !desired.known() {
+		return StatusActionNone, fmt.Errorf("unknown desired member status '%s'", desired)
+	}
+	switch desired {
+	case StatusCleaned:
+		return StatusActionNone, fmt.Errorf(
+			"can not set member status to '%s', MailChimp cleans members whose email address bounces",
+			desired,
+		)
+	case StatusArchived:
+		return StatusActionNone, fmt.Errorf(
+			"can not set member status to '%s', members are archived with ArchiveMember",
+			desired,
+		)
+	}
+	if current == StatusCleaned {
+		return StatusActionNone, fmt.Errorf(
+			"can not change member status from '%s' to '%s', cleaned members can not be changed through the API",
+			current,
+			desired,
+		)
+	}
+	switch desired {
+	case StatusSubscribed:
+		if current == StatusUnsubscribed {
+			return StatusActionNone, fmt.Errorf(
+				"can not change member status from '%s' to '%s', unsubscribed members must be resubscribed through '%s'",
+				current,
+				desired,
+				StatusPending,
+			)
+		}
+		return StatusActionSubscribe, nil
+	case StatusPending:
+		if current == StatusSubscribed {
+			return StatusActionNone, fmt.Errorf(
+				"can not change member status from '%s' to '%s', the member is already subscribed",
+				current,
+				desired,
+			)
+		}
+		return StatusActionConfirm, nil
+	case StatusUnsubscribed:
+		return StatusActionUnsubscribe, nil
+	}
+	// The only remaining status is transactional, which is only
+	// available to members that are not already on the list.
+	if current != "" && current != StatusArchived {
+		return StatusActionNone, fmt.Errorf(
+			"can not change member status from '%s' to '%s', only new members can be added as transactional",
+			current,
+			desired,
+		)
+	}
+	return StatusActionTransactional, nil
+}
@@ -0,0 +1,75 @@
+package mailchimp
+
+import "testing"
+
+func TestMemberStatusAction_LegalTransitions(t *testing.T) {
+	transitions := []struct {
+		current  MemberStatus
+		desired  MemberStatus
+		expected StatusAction
+	}{
+		{"", StatusSubscribed, StatusActionSubscribe},
+		{"", StatusPending, StatusActionConfirm},
+		{"", StatusUnsubscribed, StatusActionUnsubscribe},
+		{"", "", StatusActionNone},
+		{StatusSubscribed, StatusSubscribed, StatusActionNone},
+		{StatusSubscribed, StatusUnsubscribed, StatusActionUnsubscribe},
+		{StatusUnsubscribed, StatusPending, StatusActionConfirm},
+		{StatusPending, StatusSubscribed, StatusActionSubscribe},
+		{StatusPending, StatusUnsubscribed, StatusActionUnsubscribe},
+		{"", StatusTransactional, StatusActionTransactional},
+		{StatusTransactional, StatusSubscribed, StatusActionSubscribe},
+		{StatusArchived, StatusSubscribed, StatusActionSubscribe},
+		{StatusArchived, StatusTransactional, StatusActionTransactional},
+	}
+	for _, transition := range transitions {
+		action, err := MemberStatusAction(transition.current, transition.desired)
+		if err != nil {
+			t.Errorf(
+				"expected no error from '%s' to '%s', but got '%s'",
+				transition.current,
+				transition.desired,
+				err.Error(),
+			)
+		}
+		if action != transition.expected {
+			t.Errorf(
+				"expected action from '%s' to '%s' to be '%s' but was '%s'",
+				transition.current,
+				transition.desired,
+				transition.expected,
+				action,
+			)
+		}
+	}
+}
+
+func TestMemberStatusAction_IllegalTransitions(t *testing.T) {
+	transitions := []struct {
+		current MemberStatus
+		desired MemberStatus
+	}{
+		{"", StatusCleaned},
+		{StatusSubscribed, StatusCleaned},
+		{StatusUnsubscribed, StatusSubscribed},
+		{StatusSubscribed, StatusPending},
+		{StatusCleaned, StatusSubscribed},
+		{StatusCleaned, StatusPending},
+		{StatusCleaned, StatusUnsubscribed},
+		{"", StatusArchived},
+		{StatusSubscribed, StatusArchived},
+		{StatusSubscribed, StatusTransactional},
+		{StatusUnsubscribed, StatusTransactional},
+		{"", "unknown"},
+		{"unknown", StatusSubscribed},
+	}
+	for _, transition := range transitions {
+		if _, err := MemberStatusAction(transition.current, transition.desired); err == nil {
+			t.Errorf(
+				"expected error from '%s' to '%s', but none was returned",
+				transition.current,
+				transition.desired,
+			)
+		}
+	}
+}
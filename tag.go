@@ -43,3 +43,28 @@ func (tb TagBuilder) StatusInactive() TagBuilder {
 	tb.obj.Status = tagStatusInactive
 	return tb
 }
+
+// ListTag is a tag as it exists on a list, as opposed to Tag which
+// describes a tag on a particular member. MailChimp stores tags as
+// static segments, so the ID is shared with the segment endpoints.
+type ListTag struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type listTagCollection struct {
+	Tags       []ListTag `json:"tags"`
+	TotalItems int       `json:"total_items"`
+}
+
+// TagMemberResult describes the outcome of tagging or untagging a
+// single email address with TagMembers. Error is empty if the
+// operation succeeded for the email address.
+type TagMemberResult struct {
+	EmailAddress string
+	Error        string
+}
+
+func (r TagMemberResult) Successful() bool {
+	return r.Error == ""
+}
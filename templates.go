@@ -0,0 +1,126 @@
+package mailchimp
+
+import "fmt"
+
+var (
+	NullTemplate               = Template{}
+	NullTemplateSlice          = []Template{}
+	NullTemplateDefaultContent = TemplateDefaultContent{}
+	NullTemplateFolder         = TemplateFolder{}
+	NullTemplateFolderSlice    = []TemplateFolder{}
+)
+
+const (
+	TemplateTypeUser    = "user"
+	TemplateTypeBase    = "base"
+	TemplateTypeGallery = "gallery"
+)
+
+// templatePageSize is the number of templates fetched per request when
+// looking up a template by name.
+const templatePageSize = 1000
+
+// Template is a MailChimp email template. Only Name, FolderID and HTML
+// are sent to MailChimp, the remaining fields are read-only. MailChimp
+// does not return the HTML of a template, use FetchTemplateDefaultContent
+// to read its sections.
+type Template struct {
+	ID          int    `json:"id"`
+	Type        string `json:"type"`
+	Name        string `json:"name" mc_validator:"required"`
+	DragAndDrop bool   `json:"drag_and_drop"`
+	Responsive  bool   `json:"responsive"`
+	Category    string `json:"category"`
+	DateCreated string `json:"date_created"`
+	DateEdited  string `json:"date_edited"`
+	CreatedBy   string `json:"created_by"`
+	EditedBy    string `json:"edited_by"`
+	Active      bool   `json:"active"`
+	FolderID    string `json:"folder_id"`
+	Thumbnail   string `json:"thumbnail"`
+	ShareURL    string `json:"share_url"`
+	ContentType string `json:"content_type"`
+	HTML        string `json:"html,omitempty" mc_validator:"required"`
+}
+
+// TemplateFilters narrows down the templates returned by FetchTemplates.
+// Empty fields are not filtered on.
+type TemplateFilters struct {
+	Type     string
+	Category string
+	FolderID string
+	Page     Page
+}
+
+type templateCollection struct {
+	Templates  []Template `json:"templates"`
+	TotalItems int        `json:"total_items"`
+}
+
+// templatePayload holds the writable fields of a template. Empty fields
+// are left out, so that a template can be renamed or moved to another
+// folder without sending its HTML again.
+type templatePayload struct {
+	Name     string `json:"name,omitempty"`
+	FolderID string `json:"folder_id,omitempty"`
+	HTML     string `json:"html,omitempty"`
+}
+
+func newTemplatePayload(t Template) templatePayload {
+	return templatePayload{
+		Name:     t.Name,
+		FolderID: t.FolderID,
+		HTML:     t.HTML,
+	}
+}
+
+// TemplateDefaultContent holds the editable sections of a template,
+// keyed by the mc:edit name of each section. The keys are the section
+// names to use with NewTemplateCampaignContent.
+type TemplateDefaultContent struct {
+	Sections map[string]string `json:"sections"`
+}
+
+type TemplateFolder struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type templateFolderCollection struct {
+	Folders    []TemplateFolder `json:"folders"`
+	TotalItems int              `json:"total_items"`
+}
+
+type templateFolderPayload struct {
+	Name string `json:"name"`
+}
+
+type TemplateBuilder struct {
+	obj Template
+}
+
+func (tb TemplateBuilder) Build() (Template, error) {
+	if invalidParams, valid := validate(tb.obj); !valid {
+		return NullTemplate, fmt.Errorf(
+			"could not build template due to invalid parameters %v",
+			invalidParams,
+		)
+	}
+	return tb.obj, nil
+}
+
+func (tb TemplateBuilder) Name(name string) TemplateBuilder {
+	tb.obj.Name = name
+	return tb
+}
+
+func (tb TemplateBuilder) FolderID(folderID string) TemplateBuilder {
+	tb.obj.FolderID = folderID
+	return tb
+}
+
+func (tb TemplateBuilder) HTML(html string) TemplateBuilder {
+	tb.obj.HTML = html
+	return tb
+}
@@ -0,0 +1,32 @@
+package mailchimp
+
+import "testing"
+
+func TestTemplateBuilder_BuildShouldPass(t *testing.T) {
+	template, err := TemplateBuilder{}.
+		Name("Newsletter").
+		FolderID("folder-id").
+		HTML("<p>Hello</p>").
+		Build()
+	if err != nil {
+		t.Errorf(
+			"expected no error to be returned, but got '%s'",
+			err.Error(),
+		)
+	}
+	if template.Name != "Newsletter" || template.FolderID != "folder-id" || template.HTML != "<p>Hello</p>" {
+		t.Errorf("expected template fields to be set, but got %+v", template)
+	}
+}
+
+func TestTemplateBuilder_BuildWithoutNameReturnsError(t *testing.T) {
+	if _, err := (TemplateBuilder{}).HTML("<p>Hello</p>").Build(); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}
+
+func TestTemplateBuilder_BuildWithoutHTMLReturnsError(t *testing.T) {
+	if _, err := (TemplateBuilder{}).Name("Newsletter").Build(); err == nil {
+		t.Error("expected error to be returned, but none was")
+	}
+}